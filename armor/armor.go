@@ -1,11 +1,29 @@
+// Package armor implements the ASCII armor of RFC 4880, section 6.2:
+//
+//	-----BEGIN <type>-----
+//	<key>: <value>
+//
+//	<base64 data, in lines of 64 characters>
+//	=<base64 CRC-24 of the data>
+//	-----END <type>-----
+//
+// Headers are written sorted by key. The decoder is strict: header lines must
+// be well-formed and unique, body lines at most 76 characters, and the
+// checksum is required and verified. Text before, between and after blocks is
+// ignored, so that several blocks can be bundled in one input.
 package armor
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
+	"strings"
+)
 
-	"golang.org/x/crypto/openpgp/armor" //nolint: staticcheck
+var (
+	ErrInvalidArmor     = errors.New("armor: invalid armor")
+	ErrChecksumMismatch = errors.New("armor: checksum mismatch")
 )
 
 // ErrEncode represents an error from calling [EncodeArmor].
@@ -21,32 +39,122 @@ func (e ErrEncode) Unwrap() error {
 	return e.Err
 }
 
+// Block is an armored block with its decoded data.
+type Block struct {
+	Type    string
+	Headers map[string]string
+	Data    []byte
+}
+
 func EncodeArmor(blockType string, headers map[string]string, data []byte) (string, error) {
 	buf := new(bytes.Buffer)
-	w, err := armor.Encode(buf, blockType, headers)
-	if err != nil {
-		return "", ErrEncode{Err: err}
-	}
-	_, err = w.Write(data)
-	if err != nil {
-		return "", ErrEncode{Err: err}
-	}
-	err = w.Close()
-	if err != nil {
-		return "", ErrEncode{Err: err}
+	if err := encodeBlock(buf, blockType, headers, data); err != nil {
+		return "", err
 	}
 	return buf.String(), nil
 }
 
+// DecodeArmor decodes the first armored block of armorStr. It returns io.EOF
+// if there is none.
 func DecodeArmor(armorStr string) (blockType string, headers map[string]string, data []byte, err error) {
-	buf := bytes.NewBufferString(armorStr)
-	block, err := armor.Decode(buf)
+	d := NewDecoder(strings.NewReader(armorStr))
+	blockType, headers, body, err := d.Next()
 	if err != nil {
 		return "", nil, nil, err
 	}
-	data, err = io.ReadAll(block.Body)
+	data, err = io.ReadAll(body)
 	if err != nil {
 		return "", nil, nil, err
 	}
-	return block.Type, block.Header, data, nil
+	return blockType, headers, data, nil
+}
+
+// EncodeArmorBlocks encodes the blocks one after the other, separated by a
+// newline, as a bundle that DecodeArmorBlocks reads back.
+func EncodeArmorBlocks(blocks []Block) (string, error) {
+	buf := new(bytes.Buffer)
+	for i, block := range blocks {
+		if i > 0 {
+			buf.WriteByte('\n')
+		}
+		if err := encodeBlock(buf, block.Type, block.Headers, block.Data); err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
+}
+
+// DecodeArmorBlocks decodes all the armored blocks of armorStr, in order. It
+// fails if any of them is invalid.
+func DecodeArmorBlocks(armorStr string) ([]Block, error) {
+	d := NewDecoder(strings.NewReader(armorStr))
+	var blocks []Block
+	for {
+		blockType, headers, body, err := d.Next()
+		if err == io.EOF {
+			return blocks, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, Block{Type: blockType, Headers: headers, Data: data})
+	}
+}
+
+func encodeBlock(w io.Writer, blockType string, headers map[string]string, data []byte) error {
+	enc, err := NewEncoder(w, blockType, headers)
+	if err != nil {
+		return ErrEncode{Err: err}
+	}
+	if _, err := enc.Write(data); err != nil {
+		return ErrEncode{Err: err}
+	}
+	if err := enc.Close(); err != nil {
+		return ErrEncode{Err: err}
+	}
+	return nil
+}
+
+// validateType checks that the block type is printable ASCII that can be
+// found back between the dashes of the BEGIN and END lines.
+func validateType(blockType string) error {
+	if blockType == "" {
+		return errors.New("empty block type")
+	}
+	for i := 0; i < len(blockType); i++ {
+		if c := blockType[i]; c < 0x20 || c > 0x7e {
+			return fmt.Errorf("invalid character %q in block type", c)
+		}
+	}
+	if strings.TrimSpace(blockType) != blockType || strings.HasPrefix(blockType, "-") || strings.HasSuffix(blockType, "-") {
+		return fmt.Errorf("invalid block type %q", blockType)
+	}
+	return nil
+}
+
+// validateHeader checks that the key is non-empty printable ASCII without
+// colons, and that the value has no control characters or surrounding
+// whitespace, so that the header line parses back unchanged.
+func validateHeader(key, value string) error {
+	if key == "" {
+		return errors.New("empty header key")
+	}
+	for i := 0; i < len(key); i++ {
+		if c := key[i]; c <= 0x20 || c > 0x7e || c == ':' {
+			return fmt.Errorf("invalid header key %q", key)
+		}
+	}
+	for i := 0; i < len(value); i++ {
+		if c := value[i]; c < 0x20 || c == 0x7f {
+			return fmt.Errorf("invalid character %q in header %q", c, key)
+		}
+	}
+	if strings.TrimSpace(value) != value {
+		return fmt.Errorf("header %q has surrounding whitespace", key)
+	}
+	return nil
 }
@@ -0,0 +1,238 @@
+package armor
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// maxBodyLineLength is the longest body line allowed by RFC 4880.
+	maxBodyLineLength = 76
+	// maxLineLength bounds the BEGIN, END and header lines.
+	maxLineLength = 4096
+)
+
+var (
+	armorStart     = []byte("-----BEGIN ")
+	armorEnd       = []byte("-----END ")
+	armorEndOfLine = []byte("-----")
+)
+
+// Decoder reads the armored blocks of a stream one after the other.
+type Decoder struct {
+	r    *bufio.Reader
+	body *bodyReader
+	err  error
+}
+
+// NewDecoder returns a Decoder reading from r. The Decoder buffers r, so it
+// may read past the last block.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReaderSize(r, maxLineLength)}
+}
+
+// Next skips to the next armored block and returns its type, headers and a
+// reader of its data. The reader verifies the checksum before returning
+// io.EOF, and is only valid until the next call to Next, which discards any
+// unread data. Next returns io.EOF when there are no more blocks; any other
+// error is final.
+func (d *Decoder) Next() (blockType string, headers map[string]string, body io.Reader, err error) {
+	if d.err != nil {
+		return "", nil, nil, d.err
+	}
+	blockType, headers, err = d.next()
+	if err != nil {
+		d.err = err
+		return "", nil, nil, err
+	}
+	d.body = &bodyReader{d: d, crc: crc24Init, blockType: blockType}
+	d.body.lines.b = d.body
+	d.body.b64 = base64.NewDecoder(base64.StdEncoding.Strict(), &d.body.lines)
+	return blockType, headers, d.body, nil
+}
+
+func (d *Decoder) next() (blockType string, headers map[string]string, err error) {
+	if d.body != nil {
+		if _, err := io.Copy(io.Discard, d.body); err != nil {
+			return "", nil, err
+		}
+		d.body = nil
+	}
+
+	// Skip anything before the BEGIN line.
+	var line []byte
+	for {
+		line, err = d.readLine(true)
+		if err != nil {
+			return "", nil, err
+		}
+		if len(line) > len(armorStart)+len(armorEndOfLine) &&
+			bytes.HasPrefix(line, armorStart) && bytes.HasSuffix(line, armorEndOfLine) {
+			break
+		}
+	}
+	blockType = string(line[len(armorStart) : len(line)-len(armorEndOfLine)])
+	if err := validateType(blockType); err != nil {
+		return "", nil, fmt.Errorf("%w: %v", ErrInvalidArmor, err)
+	}
+
+	headers = make(map[string]string)
+	for {
+		line, err = d.readLine(false)
+		if err != nil {
+			return "", nil, unexpectedEOF(err)
+		}
+		if len(line) == 0 {
+			return blockType, headers, nil
+		}
+		key, value, ok := bytes.Cut(line, []byte(": "))
+		if !ok {
+			// A header with an empty value loses its trailing space.
+			key, ok = bytes.CutSuffix(line, []byte(":"))
+		}
+		if !ok {
+			return "", nil, fmt.Errorf("%w: malformed header line %q", ErrInvalidArmor, line)
+		}
+		if err := validateHeader(string(key), string(value)); err != nil {
+			return "", nil, fmt.Errorf("%w: %v", ErrInvalidArmor, err)
+		}
+		if _, ok := headers[string(key)]; ok {
+			return "", nil, fmt.Errorf("%w: duplicate header %q", ErrInvalidArmor, key)
+		}
+		headers[string(key)] = string(value)
+	}
+}
+
+// readLine returns the next line without its line ending and trailing
+// whitespace. Lines longer than the buffer are an error, or skipped entirely
+// if skipLong is set. It returns io.EOF at the end of the input.
+func (d *Decoder) readLine(skipLong bool) ([]byte, error) {
+	for {
+		line, err := d.r.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			if !skipLong {
+				return nil, fmt.Errorf("%w: line too long", ErrInvalidArmor)
+			}
+			for err == bufio.ErrBufferFull {
+				_, err = d.r.ReadSlice('\n')
+			}
+			if err != nil && err != io.EOF {
+				return nil, err
+			}
+			continue
+		}
+		if err == io.EOF && len(line) > 0 {
+			err = nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return bytes.TrimRight(line, " \t\r\n"), nil
+	}
+}
+
+// bodyReader decodes the data of a block and checks its checksum.
+type bodyReader struct {
+	d         *Decoder
+	lines     lineReader
+	b64       io.Reader
+	crc       uint32
+	blockType string
+	err       error
+}
+
+func (b *bodyReader) Read(p []byte) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+	n, err := b.b64.Read(p)
+	b.crc = crc24(b.crc, p[:n])
+	var corrupt base64.CorruptInputError
+	switch {
+	case err == io.EOF:
+		if b.crc != b.lines.crc {
+			err = ErrChecksumMismatch
+		}
+	case err == io.ErrUnexpectedEOF:
+		err = fmt.Errorf("%w: truncated base64 data", ErrInvalidArmor)
+	case errors.As(err, &corrupt):
+		err = fmt.Errorf("%w: %v", ErrInvalidArmor, err)
+	}
+	if err != nil {
+		b.err = err
+		if err != io.EOF {
+			b.d.err = err
+		}
+	}
+	return n, err
+}
+
+// lineReader returns the content of the body lines of a block. It stops at
+// the checksum line, which must be followed by the END line.
+type lineReader struct {
+	b    *bodyReader
+	buf  []byte
+	crc  uint32
+	done bool
+}
+
+func (l *lineReader) Read(p []byte) (int, error) {
+	if l.done {
+		return 0, io.EOF
+	}
+	for len(l.buf) == 0 {
+		line, err := l.b.d.readLine(false)
+		if err != nil {
+			return 0, unexpectedEOF(err)
+		}
+		if len(line) > 0 && line[0] == '=' {
+			return 0, l.readTrailer(line)
+		}
+		if bytes.HasPrefix(line, armorEndOfLine) {
+			return 0, fmt.Errorf("%w: missing checksum", ErrInvalidArmor)
+		}
+		if len(line) > maxBodyLineLength {
+			return 0, fmt.Errorf("%w: body line too long", ErrInvalidArmor)
+		}
+		l.buf = line
+	}
+	n := copy(p, l.buf)
+	l.buf = l.buf[n:]
+	return n, nil
+}
+
+// readTrailer parses the checksum line and checks the END line that follows.
+// It returns io.EOF on success.
+func (l *lineReader) readTrailer(line []byte) error {
+	var checksum [3]byte
+	if len(line) != 5 {
+		return fmt.Errorf("%w: malformed checksum", ErrInvalidArmor)
+	}
+	if _, err := base64.StdEncoding.Strict().Decode(checksum[:], line[1:]); err != nil {
+		return fmt.Errorf("%w: malformed checksum", ErrInvalidArmor)
+	}
+	l.crc = uint32(checksum[0])<<16 | uint32(checksum[1])<<8 | uint32(checksum[2])
+
+	line, err := l.b.d.readLine(false)
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	end := string(armorEnd) + l.b.blockType + string(armorEndOfLine)
+	if string(line) != end {
+		return fmt.Errorf("%w: expected %q, got %q", ErrInvalidArmor, end, line)
+	}
+	l.done = true
+	return io.EOF
+}
+
+// unexpectedEOF reports the end of the input inside a block as invalid armor.
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return fmt.Errorf("%w: unexpected end of input", ErrInvalidArmor)
+	}
+	return err
+}
@@ -0,0 +1,109 @@
+package armor
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"sort"
+)
+
+// lineLength is the length of the base64 lines written by Encoder.
+const lineLength = 64
+
+// encoder armors the data written to it. It is built into a stack of
+// io.Writers:
+//
+//	encoder -> base64 encoder -> lineWriter -> out
+type encoder struct {
+	out       io.Writer
+	b64       io.WriteCloser
+	crc       uint32
+	blockType string
+	closed    bool
+}
+
+// NewEncoder writes the BEGIN line and headers of a block to w, and returns a
+// WriteCloser that armors the data written to it. Close writes the checksum
+// and END line; it does not close w.
+func NewEncoder(w io.Writer, blockType string, headers map[string]string) (io.WriteCloser, error) {
+	if err := validateType(blockType); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(headers))
+	for key, value := range headers {
+		if err := validateHeader(key, value); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if _, err := io.WriteString(w, "-----BEGIN "+blockType+"-----\n"); err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if _, err := io.WriteString(w, key+": "+headers[key]+"\n"); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return nil, err
+	}
+	return &encoder{
+		out:       w,
+		b64:       base64.NewEncoder(base64.StdEncoding, &lineWriter{out: w}),
+		crc:       crc24Init,
+		blockType: blockType,
+	}, nil
+}
+
+func (e *encoder) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("armor: write to closed encoder")
+	}
+	e.crc = crc24(e.crc, p)
+	return e.b64.Write(p)
+}
+
+func (e *encoder) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	if err := e.b64.Close(); err != nil {
+		return err
+	}
+	checksum := []byte{byte(e.crc >> 16), byte(e.crc >> 8), byte(e.crc)}
+	_, err := io.WriteString(e.out, "\n="+base64.StdEncoding.EncodeToString(checksum)+
+		"\n-----END "+e.blockType+"-----")
+	return err
+}
+
+// lineWriter breaks the data written to it into lines of lineLength bytes.
+// The newline is written before the next line, so the last line has none.
+type lineWriter struct {
+	out  io.Writer
+	used int
+}
+
+var newline = []byte{'\n'}
+
+func (l *lineWriter) Write(b []byte) (int, error) {
+	n := 0
+	for len(b) > 0 {
+		if l.used == lineLength {
+			if _, err := l.out.Write(newline); err != nil {
+				return n, err
+			}
+			l.used = 0
+		}
+		k := min(lineLength-l.used, len(b))
+		if _, err := l.out.Write(b[:k]); err != nil {
+			return n, err
+		}
+		l.used += k
+		n += k
+		b = b[k:]
+	}
+	return n, nil
+}
@@ -0,0 +1,115 @@
+package armor
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/blowfish"
+
+	"github.com/cosmos/crypto/random"
+	"github.com/cosmos/crypto/symmetric/xsalsa20symmetric"
+)
+
+// The legacy format is the one of the SDK keyring: the key is the SHA-256 of
+// the bcrypt hash of the passphrase, with the salt from the salt header and a
+// cost of 12, and the body is an xsalsa20symmetric.EncryptSymmetric
+// ciphertext, without envelope header. Its kdf header is "bcrypt".
+const (
+	legacyKDF        = "bcrypt"
+	legacySaltSize   = 16
+	legacyAlgo       = "secp256k1"
+	legacyBcryptCost = 12
+)
+
+var bcryptEncoding = base64.NewEncoding("./ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789").WithPadding(base64.NoPadding)
+
+// EncryptArmorPrivKeyLegacy is like EncryptArmorPrivKey but writes the bcrypt
+// format of the SDK keyring, for exporting keys to releases which cannot read
+// the current format.
+func EncryptArmorPrivKeyLegacy(privKeyBytes []byte, passphrase string, algo string) (string, error) {
+	salt := random.CRandBytes(legacySaltSize)
+	key := legacyKey(passphrase, salt)
+	defer clear(key)
+	ciphertext, err := xsalsa20symmetric.Encrypt(privKeyBytes, key)
+	if err != nil {
+		return "", err
+	}
+	headers := map[string]string{
+		headerKDF:  legacyKDF,
+		headerSalt: fmt.Sprintf("%X", salt),
+	}
+	if algo != "" {
+		headers[headerType] = algo
+	}
+	return EncodeArmor(PrivKeyBlockType, headers, ciphertext)
+}
+
+// decryptLegacyPrivKey decrypts the body of a legacy armored private key.
+func decryptLegacyPrivKey(headers map[string]string, ciphertext []byte, passphrase string) (privKeyBytes []byte, algo string, err error) {
+	salt, err := decodeSalt(headers)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(salt) != legacySaltSize {
+		return nil, "", fmt.Errorf("armor: bcrypt salt must be %d bytes", legacySaltSize)
+	}
+	key := legacyKey(passphrase, salt)
+	defer clear(key)
+	privKeyBytes, err = xsalsa20symmetric.Decrypt(ciphertext, key)
+	if errors.Is(err, xsalsa20symmetric.ErrCiphertextDecryption) {
+		return nil, "", ErrInvalidPassphrase
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	algo = headers[headerType]
+	if algo == "" {
+		// The SDK keyring omits the type of secp256k1 keys.
+		algo = legacyAlgo
+	}
+	return privKeyBytes, algo, nil
+}
+
+// legacyKey derives the encryption key of the legacy format.
+func legacyKey(passphrase string, salt []byte) []byte {
+	hash := bcrypt([]byte(passphrase), salt, legacyBcryptCost)
+	defer clear(hash)
+	key := sha256.Sum256(hash)
+	return key[:]
+}
+
+// bcrypt returns the bcrypt hash of the password, in the modular crypt format
+// "$2a$<cost>$<salt><hash>", for the given 16-byte salt. golang.org/x/crypto
+// only hashes with random salts; passwords longer than 72 bytes are truncated
+// as by the original implementation instead of being rejected.
+func bcrypt(password, salt []byte, cost int) []byte {
+	// Bug compatibility with C bcrypt implementations: the trailing NUL of
+	// the key is used during expansion.
+	key := make([]byte, len(password)+1)
+	defer clear(key)
+	copy(key, password)
+
+	c, err := blowfish.NewSaltedCipher(key, salt)
+	if err != nil {
+		// The key is never empty.
+		panic(err)
+	}
+	for i := uint64(0); i < 1<<cost; i++ {
+		blowfish.ExpandKey(key, c)
+		blowfish.ExpandKey(salt, c)
+	}
+
+	data := []byte("OrpheanBeholderScryDoubt")
+	for i := 0; i < len(data); i += blowfish.BlockSize {
+		for j := 0; j < 64; j++ {
+			c.Encrypt(data[i:i+blowfish.BlockSize], data[i:i+blowfish.BlockSize])
+		}
+	}
+
+	// Only 23 of the 24 encrypted bytes are encoded, as by C implementations.
+	out := fmt.Appendf(nil, "$2a$%02d$", cost)
+	out = bcryptEncoding.AppendEncode(out, salt)
+	return bcryptEncoding.AppendEncode(out, data[:23])
+}
@@ -0,0 +1,169 @@
+package armor
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/crypto/kdf"
+	"github.com/cosmos/crypto/random"
+	"github.com/cosmos/crypto/symmetric"
+	_ "github.com/cosmos/crypto/symmetric/xchacha20poly1305" // register the cipher
+	_ "github.com/cosmos/crypto/symmetric/xsalsa20symmetric" // register the cipher
+)
+
+const (
+	// PrivKeyBlockType is the armor type of encrypted private keys.
+	PrivKeyBlockType = "TENDERMINT PRIVATE KEY"
+
+	// Headers of an encrypted private key. kdf holds the KDF parameters as
+	// returned by kdf.EncodeParams, or "bcrypt" in the legacy format, salt
+	// the hex-encoded salt, and type the algorithm of the key.
+	headerKDF  = "kdf"
+	headerSalt = "salt"
+	headerType = "type"
+)
+
+var (
+	// ErrInvalidPassphrase is returned when an encrypted private key fails to
+	// decrypt with the passphrase.
+	ErrInvalidPassphrase = errors.New("armor: invalid passphrase")
+	// ErrUnsupportedCipher is returned for ciphers other than
+	// symmetric.XSalsa20Poly1305 and symmetric.XChaCha20Poly1305.
+	ErrUnsupportedCipher = errors.New("armor: unsupported private key cipher")
+	// ErrKDFLimits is returned for KDF parameters exceeding kdf.DefaultLimits,
+	// which bound the memory and time an armored key from an untrusted source
+	// can make UnarmorDecryptPrivKey spend.
+	ErrKDFLimits = kdf.ErrLimitsExceeded
+)
+
+// EncryptArmorPrivKey encrypts the private key with a key derived from the
+// passphrase and armors it. algo is the algorithm of the private key, which
+// UnarmorDecryptPrivKey returns. The key is derived with
+// kdf.DefaultArgon2idParams and encrypted with XChaCha20-Poly1305.
+//
+// The kdf header holds the encoded KDF parameters, and the body is a
+// symmetric envelope recording the cipher. The SDK keyring only reads its
+// own format, with a "bcrypt" kdf header, a fixed bcrypt cost and a bare
+// XSalsa20-Poly1305 body; EncryptArmorPrivKeyLegacy writes it for exporting
+// keys to the keyring, while this format is the default so that the KDF
+// cost and the cipher can be raised without another format change.
+// UnarmorDecryptPrivKey reads both.
+func EncryptArmorPrivKey(privKeyBytes []byte, passphrase string, algo string) (string, error) {
+	return EncryptArmorPrivKeyWithParams(privKeyBytes, passphrase, algo, kdf.DefaultArgon2idParams, symmetric.XChaCha20Poly1305)
+}
+
+// EncryptArmorPrivKeyWithParams is like EncryptArmorPrivKey with the given KDF
+// parameters and cipher. The cipher must be symmetric.XSalsa20Poly1305 or
+// symmetric.XChaCha20Poly1305, and the parameters within kdf.DefaultLimits,
+// which UnarmorDecryptPrivKey enforces.
+func EncryptArmorPrivKeyWithParams(
+	privKeyBytes []byte, passphrase string, algo string, params kdf.Params, cipher symmetric.Algorithm,
+) (string, error) {
+	if err := checkCipher(cipher); err != nil {
+		return "", err
+	}
+	if err := kdf.DefaultLimits.Check(params); err != nil {
+		return "", err
+	}
+	s, err := symmetric.Get(cipher)
+	if err != nil {
+		return "", err
+	}
+	salt := random.CRandBytes(kdf.SaltSize)
+	key, _, err := kdf.DeriveWithSalt([]byte(passphrase), salt, params)
+	if err != nil {
+		return "", err
+	}
+	defer clear(key)
+
+	headers := map[string]string{
+		headerKDF:  kdf.EncodeParams(params),
+		headerSalt: fmt.Sprintf("%X", salt),
+	}
+	if algo != "" {
+		headers[headerType] = algo
+	}
+	// Both ciphers accepted by checkCipher implement SymmetricE.
+	ciphertext, err := s.(symmetric.SymmetricE).EncryptE(privKeyBytes, key)
+	if err != nil {
+		return "", err
+	}
+	return EncodeArmor(PrivKeyBlockType, headers, ciphertext)
+}
+
+// UnarmorDecryptPrivKey decrypts a private key armored by EncryptArmorPrivKey
+// or EncryptArmorPrivKeyLegacy, and returns it with its algorithm. The
+// algorithm is empty if none was recorded, except for the legacy format where
+// it defaults to secp256k1 as in the SDK keyring. It returns
+// ErrInvalidPassphrase if the passphrase is wrong, and ErrKDFLimits or
+// ErrUnsupportedCipher before deriving any key if the headers ask for
+// expensive KDF parameters or another cipher.
+func UnarmorDecryptPrivKey(armorStr string, passphrase string) (privKeyBytes []byte, algo string, err error) {
+	blockType, headers, ciphertext, err := DecodeArmor(armorStr)
+	if err != nil {
+		return nil, "", err
+	}
+	if blockType != PrivKeyBlockType {
+		return nil, "", fmt.Errorf("armor: unrecognized armor type %q, expected %q", blockType, PrivKeyBlockType)
+	}
+	encodedParams, ok := headers[headerKDF]
+	if !ok {
+		return nil, "", fmt.Errorf("armor: missing %s header", headerKDF)
+	}
+	if encodedParams == legacyKDF {
+		return decryptLegacyPrivKey(headers, ciphertext, passphrase)
+	}
+	params, err := kdf.ParseParams(encodedParams)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := kdf.DefaultLimits.Check(params); err != nil {
+		return nil, "", err
+	}
+	salt, err := decodeSalt(headers)
+	if err != nil {
+		return nil, "", err
+	}
+	cipher, err := symmetric.ParseHeader(ciphertext)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := checkCipher(cipher); err != nil {
+		return nil, "", err
+	}
+
+	key, _, err := kdf.DeriveWithSalt([]byte(passphrase), salt, params)
+	if err != nil {
+		return nil, "", err
+	}
+	defer clear(key)
+	privKeyBytes, err = symmetric.Decrypt(ciphertext, key)
+	if errors.Is(err, symmetric.ErrDecryption) {
+		return nil, "", ErrInvalidPassphrase
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return privKeyBytes, headers[headerType], nil
+}
+
+// decodeSalt returns the salt recorded in the headers.
+func decodeSalt(headers map[string]string) ([]byte, error) {
+	saltHex, ok := headers[headerSalt]
+	if !ok {
+		return nil, fmt.Errorf("armor: missing %s header", headerSalt)
+	}
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return nil, fmt.Errorf("armor: invalid salt: %w", err)
+	}
+	return salt, nil
+}
+
+func checkCipher(cipher symmetric.Algorithm) error {
+	if cipher != symmetric.XSalsa20Poly1305 && cipher != symmetric.XChaCha20Poly1305 {
+		return fmt.Errorf("%w %v", ErrUnsupportedCipher, cipher)
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package armor
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	xbcrypt "golang.org/x/crypto/bcrypt"
+
+	"github.com/cosmos/crypto/kdf"
+	"github.com/cosmos/crypto/symmetric"
+	"github.com/cosmos/crypto/symmetric/aes256gcm"
+)
+
+// Cheap parameters so that the tests run quickly.
+var testKDFParams = kdf.Argon2idParams{Memory: 64, Time: 1, Threads: 1}
+
+func TestEncryptArmorPrivKey(t *testing.T) {
+	privKey := []byte("0123456789abcdef0123456789abcdef")
+	for _, cipher := range []symmetric.Algorithm{symmetric.XSalsa20Poly1305, symmetric.XChaCha20Poly1305} {
+		t.Run(cipher.String(), func(t *testing.T) {
+			armored, err := EncryptArmorPrivKeyWithParams(privKey, "passphrase", "secp256k1", testKDFParams, cipher)
+			require.NoError(t, err)
+
+			blockType, headers, _, err := DecodeArmor(armored)
+			require.NoError(t, err)
+			require.Equal(t, PrivKeyBlockType, blockType)
+			require.Equal(t, "$argon2id$v=19$m=64,t=1,p=1", headers["kdf"])
+			require.Len(t, headers["salt"], 2*kdf.SaltSize)
+			require.Equal(t, "secp256k1", headers["type"])
+
+			decrypted, algo, err := UnarmorDecryptPrivKey(armored, "passphrase")
+			require.NoError(t, err)
+			require.Equal(t, privKey, decrypted)
+			require.Equal(t, "secp256k1", algo)
+
+			_, _, err = UnarmorDecryptPrivKey(armored, "wrong")
+			require.ErrorIs(t, err, ErrInvalidPassphrase)
+		})
+	}
+}
+
+func TestEncryptArmorPrivKeyDefaults(t *testing.T) {
+	armored, err := EncryptArmorPrivKey([]byte("key"), "passphrase", "")
+	require.NoError(t, err)
+	_, headers, _, err := DecodeArmor(armored)
+	require.NoError(t, err)
+	require.Equal(t, kdf.EncodeParams(kdf.DefaultArgon2idParams), headers["kdf"])
+	require.NotContains(t, headers, "type")
+
+	decrypted, algo, err := UnarmorDecryptPrivKey(armored, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, []byte("key"), decrypted)
+	require.Empty(t, algo)
+}
+
+func TestUnarmorDecryptPrivKeyInvalid(t *testing.T) {
+	privKey := []byte("key")
+	armored, err := EncryptArmorPrivKeyWithParams(privKey, "passphrase", "ed25519", testKDFParams, symmetric.XChaCha20Poly1305)
+	require.NoError(t, err)
+	_, headers, ciphertext, err := DecodeArmor(armored)
+	require.NoError(t, err)
+
+	withHeaders := func(blockType string, edit func(map[string]string)) string {
+		h := make(map[string]string, len(headers))
+		for k, v := range headers {
+			h[k] = v
+		}
+		edit(h)
+		armored, err := EncodeArmor(blockType, h, ciphertext)
+		require.NoError(t, err)
+		return armored
+	}
+
+	cases := map[string]string{
+		"block type":   withHeaders("TENDERMINT PUBLIC KEY", func(map[string]string) {}),
+		"missing kdf":  withHeaders(PrivKeyBlockType, func(h map[string]string) { delete(h, "kdf") }),
+		"bcrypt kdf":   withHeaders(PrivKeyBlockType, func(h map[string]string) { h["kdf"] = "bcrypt" }),
+		"missing salt": withHeaders(PrivKeyBlockType, func(h map[string]string) { delete(h, "salt") }),
+		"bad salt":     withHeaders(PrivKeyBlockType, func(h map[string]string) { h["salt"] = "XYZ" }),
+		"short salt":   withHeaders(PrivKeyBlockType, func(h map[string]string) { h["salt"] = "00" }),
+		"other salt":   withHeaders(PrivKeyBlockType, func(h map[string]string) { h["salt"] = strings.Repeat("00", kdf.SaltSize) }),
+	}
+	for name, armored := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := UnarmorDecryptPrivKey(armored, "passphrase")
+			require.Error(t, err)
+		})
+	}
+
+	for _, cipher := range []symmetric.Algorithm{symmetric.AES256GCM, symmetric.Algorithm(9)} {
+		_, err = EncryptArmorPrivKeyWithParams(privKey, "passphrase", "", testKDFParams, cipher)
+		require.ErrorIs(t, err, ErrUnsupportedCipher)
+	}
+}
+
+func TestUnarmorDecryptPrivKeyLimits(t *testing.T) {
+	s, err := symmetric.Get(symmetric.XChaCha20Poly1305)
+	require.NoError(t, err)
+	body := s.Encrypt([]byte("key"), s.Keygen())
+	salt := strings.Repeat("00", kdf.SaltSize)
+
+	for _, encoded := range []string{
+		"$scrypt$ln=30,r=536870912,p=1",
+		"$scrypt$ln=30,r=8,p=1",
+		"$scrypt$ln=19,r=8,p=1",
+		"$scrypt$ln=4,r=8,p=16",
+		"$argon2id$v=19$m=4194304,t=1,p=1",
+		"$argon2id$v=19$m=64,t=64,p=1",
+		"$argon2id$v=19$m=4096,t=1,p=255",
+		"$pbkdf2-sha256$i=10000000",
+	} {
+		armored, err := EncodeArmor(PrivKeyBlockType, map[string]string{"kdf": encoded, "salt": salt}, body)
+		require.NoError(t, err)
+		_, _, err = UnarmorDecryptPrivKey(armored, "passphrase")
+		require.Error(t, err, encoded)
+		if !errors.Is(err, kdf.ErrInvalidParams) {
+			require.ErrorIs(t, err, ErrKDFLimits, encoded)
+		}
+	}
+
+	// Parameters that could not be read back are not written either.
+	_, err = EncryptArmorPrivKeyWithParams([]byte("key"), "passphrase", "", kdf.PBKDF2Params{Iterations: kdf.DefaultLimits.MaxPBKDF2Iterations + 1}, symmetric.XChaCha20Poly1305)
+	require.ErrorIs(t, err, ErrKDFLimits)
+}
+
+func TestUnarmorDecryptPrivKeyCipher(t *testing.T) {
+	key, _, err := kdf.DeriveWithSalt([]byte("passphrase"), make([]byte, kdf.SaltSize), testKDFParams)
+	require.NoError(t, err)
+	body := aes256gcm.NewSymmetric().Encrypt([]byte("key"), key)
+
+	armored, err := EncodeArmor(PrivKeyBlockType, map[string]string{
+		"kdf":  kdf.EncodeParams(testKDFParams),
+		"salt": strings.Repeat("00", kdf.SaltSize),
+	}, body)
+	require.NoError(t, err)
+	_, _, err = UnarmorDecryptPrivKey(armored, "passphrase")
+	require.ErrorIs(t, err, ErrUnsupportedCipher)
+}
+
+func TestEncryptArmorPrivKeyLegacy(t *testing.T) {
+	privKey := []byte("0123456789abcdef0123456789abcdef")
+	armored, err := EncryptArmorPrivKeyLegacy(privKey, "passphrase", "")
+	require.NoError(t, err)
+
+	blockType, headers, body, err := DecodeArmor(armored)
+	require.NoError(t, err)
+	require.Equal(t, PrivKeyBlockType, blockType)
+	require.Equal(t, map[string]string{"kdf": "bcrypt", "salt": headers["salt"]}, headers)
+	require.Len(t, headers["salt"], 2*legacySaltSize)
+	// The body is a bare secretbox, without envelope header.
+	require.Len(t, body, 24+16+len(privKey))
+
+	decrypted, algo, err := UnarmorDecryptPrivKey(armored, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, privKey, decrypted)
+	require.Equal(t, "secp256k1", algo)
+
+	_, _, err = UnarmorDecryptPrivKey(armored, "wrong")
+	require.ErrorIs(t, err, ErrInvalidPassphrase)
+
+	armored, err = EncryptArmorPrivKeyLegacy(privKey, "passphrase", "ed25519")
+	require.NoError(t, err)
+	_, algo, err = UnarmorDecryptPrivKey(armored, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, "ed25519", algo)
+}
+
+func TestBcrypt(t *testing.T) {
+	salt := []byte("0123456789abcdef")
+	for _, password := range []string{"", "passphrase", strings.Repeat("x", 72)} {
+		hash := bcrypt([]byte(password), salt, 4)
+		require.Len(t, hash, 60)
+		require.Equal(t, "$2a$04$", string(hash[:7]))
+		require.NoError(t, xbcrypt.CompareHashAndPassword(hash, []byte(password)), password)
+	}
+}
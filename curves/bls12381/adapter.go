@@ -0,0 +1,320 @@
+package blst
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
+
+	cmtjson "github.com/cosmos/crypto/internal/libs/json"
+	"github.com/cosmos/crypto/types"
+)
+
+const (
+	// KeyType is the type string reported by BLS12-381 keys through the
+	// generic types.PubKey and types.PrivKey interfaces.
+	KeyType = "bls12_381"
+
+	PrivKeyName = "cometbft/PrivKeyBls12_381"
+	PubKeyName  = "cometbft/PubKeyBls12_381"
+
+	// MinSigKeyType is the type string reported by minimal-signature-size
+	// keys through the generic types.PubKey and types.PrivKey interfaces.
+	MinSigKeyType = "bls12_381_minsig"
+
+	MinSigPrivKeyName = "cometbft/PrivKeyBls12_381MinSig"
+	MinSigPubKeyName  = "cometbft/PubKeyBls12_381MinSig"
+)
+
+func init() {
+	cmtjson.RegisterType(PubKeyAdapter{}, PubKeyName)
+	cmtjson.RegisterType(PrivKeyAdapter{}, PrivKeyName)
+	cmtjson.RegisterType(MinSigPubKeyAdapter{}, MinSigPubKeyName)
+	cmtjson.RegisterType(MinSigPrivKeyAdapter{}, MinSigPrivKeyName)
+}
+
+var (
+	_ types.PubKey                       = PubKeyAdapter{}
+	_ types.PrivKey[PubKeyAdapter]       = PrivKeyAdapter{}
+	_ types.PubKey                       = MinSigPubKeyAdapter{}
+	_ types.PrivKey[MinSigPubKeyAdapter] = MinSigPrivKeyAdapter{}
+)
+
+// ErrEmptyKey is returned when signing with the zero value of an adapter.
+var ErrEmptyKey = errors.New("adapter holds no key")
+
+// PubKeyAdapter wraps a BLS public key so that it satisfies types.PubKey. The
+// zero value holds no key: Bytes and Address return nil, and it neither
+// verifies signatures nor equals any key.
+type PubKeyAdapter struct {
+	key PubKey
+}
+
+// NewPubKeyAdapter wraps the given BLS public key.
+func NewPubKeyAdapter(key PubKey) PubKeyAdapter {
+	return PubKeyAdapter{key: key}
+}
+
+// PubKeyAdapterFromBytes creates a wrapped BLS public key from its
+// compressed byte representation.
+func PubKeyAdapterFromBytes(pubKey []byte) (PubKeyAdapter, error) {
+	key, err := PublicKeyFromBytes(pubKey)
+	if err != nil {
+		return PubKeyAdapter{}, err
+	}
+	return PubKeyAdapter{key: key}, nil
+}
+
+// Key returns the underlying BLS public key.
+func (p PubKeyAdapter) Key() PubKey {
+	return p.key
+}
+
+// Address is the SHA256-20 of the compressed public key bytes.
+func (p PubKeyAdapter) Address() types.Address {
+	if p.key == nil {
+		return nil
+	}
+	return types.AddressHash(p.Bytes())
+}
+
+// Bytes returns the compressed public key.
+func (p PubKeyAdapter) Bytes() []byte {
+	if p.key == nil {
+		return nil
+	}
+	return p.key.Marshal()
+}
+
+// VerifySignature verifies a compressed signature over msg. Malformed
+// signatures are reported as invalid.
+func (p PubKeyAdapter) VerifySignature(msg []byte, sig []byte) bool {
+	signature, err := SignatureFromBytes(sig)
+	if err != nil {
+		return false
+	}
+	return signature.Verify(p.key, msg)
+}
+
+// Equals checks if the provided public key is a BLS12-381 key equal to
+// the current one.
+func (p PubKeyAdapter) Equals(other types.PubKey) bool {
+	if p.key == nil || other.Type() != KeyType {
+		return false
+	}
+	return bytes.Equal(p.Bytes(), other.Bytes())
+}
+
+func (PubKeyAdapter) Type() string {
+	return KeyType
+}
+
+// PrivKeyAdapter wraps a BLS secret key so that it satisfies types.PrivKey.
+// As for PubKeyAdapter, the zero value holds no key and Sign returns
+// ErrEmptyKey.
+type PrivKeyAdapter struct {
+	key SecretKey
+}
+
+// NewPrivKeyAdapter wraps the given BLS secret key.
+func NewPrivKeyAdapter(key SecretKey) PrivKeyAdapter {
+	return PrivKeyAdapter{key: key}
+}
+
+// PrivKeyAdapterFromBytes creates a wrapped BLS secret key from its byte
+// representation.
+func PrivKeyAdapterFromBytes(privKey []byte) (PrivKeyAdapter, error) {
+	key, err := SecretKeyFromBytes(privKey)
+	if err != nil {
+		return PrivKeyAdapter{}, err
+	}
+	return PrivKeyAdapter{key: key}, nil
+}
+
+// Key returns the underlying BLS secret key.
+func (s PrivKeyAdapter) Key() SecretKey {
+	return s.key
+}
+
+// Bytes returns the secret key bytes.
+func (s PrivKeyAdapter) Bytes() []byte {
+	if s.key == nil {
+		return nil
+	}
+	return s.key.Marshal()
+}
+
+// Sign returns the compressed BLS signature of msg.
+func (s PrivKeyAdapter) Sign(msg []byte) ([]byte, error) {
+	if s.key == nil {
+		return nil, ErrEmptyKey
+	}
+	if s.key.IsZeroized() {
+		return nil, ErrSecretKeyZeroized
+	}
+	return s.key.Sign(msg).Marshal(), nil
+}
+
+// PubKey returns the wrapped public key corresponding to the secret key. It
+// panics with ErrSecretKeyZeroized if the key has been zeroized.
+func (s PrivKeyAdapter) PubKey() PubKeyAdapter {
+	if s.key == nil {
+		return PubKeyAdapter{}
+	}
+	return PubKeyAdapter{key: s.key.PublicKey()}
+}
+
+// Equals runs in constant time based on length of the keys. Zeroized keys
+// are equal to no key, including themselves.
+func (s PrivKeyAdapter) Equals(other types.PrivKey[PubKeyAdapter]) bool {
+	if s.key == nil || s.key.IsZeroized() || other.Type() != KeyType {
+		return false
+	}
+	a, b := s.Bytes(), other.Bytes()
+	// A zeroized key has no bytes and is equal to no key.
+	if len(b) == 0 {
+		return false
+	}
+	return subtle.ConstantTimeCompare(a, b) == 1
+}
+
+func (PrivKeyAdapter) Type() string {
+	return KeyType
+}
+
+// MinSigPubKeyAdapter wraps a minimal-signature-size public key so that it
+// satisfies types.PubKey. Its zero value behaves as the one of PubKeyAdapter.
+type MinSigPubKeyAdapter struct {
+	key PubKey
+}
+
+// NewMinSigPubKeyAdapter wraps the given minimal-signature-size public key.
+func NewMinSigPubKeyAdapter(key PubKey) MinSigPubKeyAdapter {
+	return MinSigPubKeyAdapter{key: key}
+}
+
+// MinSigPubKeyAdapterFromBytes creates a wrapped minimal-signature-size
+// public key from its compressed byte representation.
+func MinSigPubKeyAdapterFromBytes(pubKey []byte) (MinSigPubKeyAdapter, error) {
+	key, err := minSigPublicKeyFromBytes(pubKey)
+	if err != nil {
+		return MinSigPubKeyAdapter{}, err
+	}
+	return MinSigPubKeyAdapter{key: key}, nil
+}
+
+// Key returns the underlying BLS public key.
+func (p MinSigPubKeyAdapter) Key() PubKey {
+	return p.key
+}
+
+// Address is the SHA256-20 of the compressed public key bytes.
+func (p MinSigPubKeyAdapter) Address() types.Address {
+	if p.key == nil {
+		return nil
+	}
+	return types.AddressHash(p.Bytes())
+}
+
+// Bytes returns the compressed public key.
+func (p MinSigPubKeyAdapter) Bytes() []byte {
+	if p.key == nil {
+		return nil
+	}
+	return p.key.Marshal()
+}
+
+// VerifySignature verifies a compressed signature over msg. Malformed
+// signatures are reported as invalid.
+func (p MinSigPubKeyAdapter) VerifySignature(msg []byte, sig []byte) bool {
+	signature, err := minSigSignatureFromBytes(sig)
+	if err != nil {
+		return false
+	}
+	return signature.Verify(p.key, msg)
+}
+
+// Equals checks if the provided public key is a minimal-signature-size
+// BLS12-381 key equal to the current one.
+func (p MinSigPubKeyAdapter) Equals(other types.PubKey) bool {
+	if p.key == nil || other.Type() != MinSigKeyType {
+		return false
+	}
+	return bytes.Equal(p.Bytes(), other.Bytes())
+}
+
+func (MinSigPubKeyAdapter) Type() string {
+	return MinSigKeyType
+}
+
+// MinSigPrivKeyAdapter wraps a BLS secret key so that it satisfies
+// types.PrivKey, signing with the minimal-signature-size variant. Its zero
+// value behaves as the one of PrivKeyAdapter.
+type MinSigPrivKeyAdapter struct {
+	key SecretKey
+}
+
+// NewMinSigPrivKeyAdapter wraps the given BLS secret key.
+func NewMinSigPrivKeyAdapter(key SecretKey) MinSigPrivKeyAdapter {
+	return MinSigPrivKeyAdapter{key: key}
+}
+
+// MinSigPrivKeyAdapterFromBytes creates a wrapped BLS secret key from its
+// byte representation.
+func MinSigPrivKeyAdapterFromBytes(privKey []byte) (MinSigPrivKeyAdapter, error) {
+	key, err := SecretKeyFromBytes(privKey)
+	if err != nil {
+		return MinSigPrivKeyAdapter{}, err
+	}
+	return MinSigPrivKeyAdapter{key: key}, nil
+}
+
+// Key returns the underlying BLS secret key.
+func (s MinSigPrivKeyAdapter) Key() SecretKey {
+	return s.key
+}
+
+// Bytes returns the secret key bytes.
+func (s MinSigPrivKeyAdapter) Bytes() []byte {
+	if s.key == nil {
+		return nil
+	}
+	return s.key.Marshal()
+}
+
+// Sign returns the compressed minimal-signature-size signature of msg.
+func (s MinSigPrivKeyAdapter) Sign(msg []byte) ([]byte, error) {
+	if s.key == nil {
+		return nil, ErrEmptyKey
+	}
+	if s.key.IsZeroized() {
+		return nil, ErrSecretKeyZeroized
+	}
+	return minSigScheme{}.Sign(s.key, msg).Marshal(), nil
+}
+
+// PubKey returns the wrapped public key corresponding to the secret key. It
+// panics with ErrSecretKeyZeroized if the key has been zeroized.
+func (s MinSigPrivKeyAdapter) PubKey() MinSigPubKeyAdapter {
+	if s.key == nil {
+		return MinSigPubKeyAdapter{}
+	}
+	return MinSigPubKeyAdapter{key: minSigScheme{}.PublicKey(s.key)}
+}
+
+// Equals runs in constant time based on length of the keys. Zeroized keys
+// are equal to no key, including themselves.
+func (s MinSigPrivKeyAdapter) Equals(other types.PrivKey[MinSigPubKeyAdapter]) bool {
+	if s.key == nil || s.key.IsZeroized() || other.Type() != MinSigKeyType {
+		return false
+	}
+	a, b := s.Bytes(), other.Bytes()
+	// A zeroized key has no bytes and is equal to no key.
+	if len(b) == 0 {
+		return false
+	}
+	return subtle.ConstantTimeCompare(a, b) == 1
+}
+
+func (MinSigPrivKeyAdapter) Type() string {
+	return MinSigKeyType
+}
@@ -0,0 +1,147 @@
+package blst_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	blst "github.com/cosmos/crypto/curves/bls12381"
+	"github.com/cosmos/crypto/curves/ed25519"
+	"github.com/cosmos/crypto/types"
+)
+
+func TestAdapterSignVerify(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	var privKey types.PrivKey[blst.PubKeyAdapter] = blst.NewPrivKeyAdapter(priv)
+	var pubKey types.PubKey = privKey.PubKey()
+
+	msg := []byte("hello crypto")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	assert.Len(t, sig, blst.SignatureLength)
+
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+	assert.False(t, pubKey.VerifySignature([]byte("another message"), sig))
+	assert.False(t, pubKey.VerifySignature(msg, sig[1:]))
+}
+
+func TestAdapterAddressAndType(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	privKey := blst.NewPrivKeyAdapter(priv)
+	pubKey := privKey.PubKey()
+
+	assert.Equal(t, blst.KeyType, pubKey.Type())
+	assert.Equal(t, blst.KeyType, privKey.Type())
+	assert.Equal(t, priv.PublicKey().Marshal(), pubKey.Bytes())
+	assert.Equal(t, types.AddressHash(pubKey.Bytes()), pubKey.Address())
+	assert.Len(t, pubKey.Address(), types.AddressSize)
+}
+
+func TestAdapterFromBytesAndEquals(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	privKey := blst.NewPrivKeyAdapter(priv)
+	pubKey := privKey.PubKey()
+
+	privKey2, err := blst.PrivKeyAdapterFromBytes(privKey.Bytes())
+	require.NoError(t, err)
+	assert.True(t, privKey.Equals(privKey2))
+
+	pubKey2, err := blst.PubKeyAdapterFromBytes(pubKey.Bytes())
+	require.NoError(t, err)
+	assert.True(t, pubKey.Equals(pubKey2))
+	assert.True(t, pubKey.Key().Equals(pubKey2.Key()))
+
+	other, err := blst.RandKey()
+	require.NoError(t, err)
+	assert.False(t, privKey.Equals(blst.NewPrivKeyAdapter(other)))
+	assert.False(t, pubKey.Equals(blst.NewPubKeyAdapter(other.PublicKey())))
+	assert.False(t, pubKey.Equals(ed25519.GenPrivKey().PubKey()))
+
+	_, err = blst.PubKeyAdapterFromBytes(pubKey.Bytes()[1:])
+	assert.ErrorContains(t, err, "public key must be 48 bytes")
+}
+
+func TestMinSigAdapterSignVerify(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	var privKey types.PrivKey[blst.MinSigPubKeyAdapter] = blst.NewMinSigPrivKeyAdapter(priv)
+	var pubKey types.PubKey = privKey.PubKey()
+
+	msg := []byte("hello crypto")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	assert.Len(t, sig, blst.MinSigSignatureLength)
+	assert.Len(t, pubKey.Bytes(), blst.MinSigPubkeyLength)
+	assert.Equal(t, blst.MinSigKeyType, pubKey.Type())
+	assert.Equal(t, blst.MinSigKeyType, privKey.Type())
+
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+	assert.False(t, pubKey.VerifySignature([]byte("another message"), sig))
+	assert.False(t, pubKey.VerifySignature(msg, sig[1:]))
+
+	// Keys and signatures of the two variants never mix.
+	minPubKey := blst.NewPrivKeyAdapter(priv).PubKey()
+	assert.False(t, pubKey.Equals(minPubKey))
+	assert.False(t, minPubKey.VerifySignature(msg, sig))
+
+	pubKey2, err := blst.MinSigPubKeyAdapterFromBytes(pubKey.Bytes())
+	require.NoError(t, err)
+	assert.True(t, pubKey.Equals(pubKey2))
+	privKey2, err := blst.MinSigPrivKeyAdapterFromBytes(privKey.Bytes())
+	require.NoError(t, err)
+	assert.True(t, privKey.Equals(privKey2))
+}
+
+func TestAdapterZeroValue(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	msg := []byte("hello crypto")
+
+	for _, test := range []struct {
+		name    string
+		pubKey  types.PubKey
+		privKey interface {
+			Bytes() []byte
+			Sign(msg []byte) ([]byte, error)
+		}
+		other types.PubKey
+		sig   []byte
+	}{
+		{
+			name:    "minimal-pubkey-size",
+			pubKey:  blst.PubKeyAdapter{},
+			privKey: blst.PrivKeyAdapter{},
+			other:   blst.NewPrivKeyAdapter(priv).PubKey(),
+			sig:     priv.Sign(msg).Marshal(),
+		},
+		{
+			name:    "minimal-signature-size",
+			pubKey:  blst.MinSigPubKeyAdapter{},
+			privKey: blst.MinSigPrivKeyAdapter{},
+			other:   blst.NewMinSigPrivKeyAdapter(priv).PubKey(),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Nil(t, test.pubKey.Bytes())
+			assert.Nil(t, test.pubKey.Address())
+			assert.False(t, test.pubKey.Equals(test.other))
+			assert.False(t, test.pubKey.Equals(test.pubKey))
+			assert.False(t, test.other.Equals(test.pubKey))
+			assert.False(t, test.pubKey.VerifySignature(msg, test.sig))
+
+			assert.Nil(t, test.privKey.Bytes())
+			_, err := test.privKey.Sign(msg)
+			assert.ErrorIs(t, err, blst.ErrEmptyKey)
+		})
+	}
+
+	assert.False(t, blst.PrivKeyAdapter{}.Equals(blst.PrivKeyAdapter{}))
+	assert.False(t, blst.NewPrivKeyAdapter(priv).Equals(blst.PrivKeyAdapter{}))
+	assert.Nil(t, blst.PrivKeyAdapter{}.PubKey().Bytes())
+	assert.False(t, blst.MinSigPrivKeyAdapter{}.Equals(blst.MinSigPrivKeyAdapter{}))
+	assert.Nil(t, blst.MinSigPrivKeyAdapter{}.PubKey().Bytes())
+}
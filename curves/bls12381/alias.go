@@ -5,3 +5,11 @@ import blst "github.com/supranational/blst/bindings/go"
 // Internal types for blst.
 type blstPublicKey = blst.P1Affine
 type blstSignature = blst.P2Affine
+type blstAggregateSignature = blst.P2Aggregate
+type blstAggregatePublicKey = blst.P1Aggregate
+
+// Internal types for blst, minimal-signature-size variant.
+type blstMinSigPublicKey = blst.P2Affine
+type blstMinSigSignature = blst.P1Affine
+type blstMinSigAggregateSignature = blst.P1Aggregate
+type blstMinSigAggregatePublicKey = blst.P2Aggregate
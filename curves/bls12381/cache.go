@@ -0,0 +1,70 @@
+package blst
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// CacheStats reports the usage of the public key cache.
+type CacheStats struct {
+	// Hits is the number of public keys served from the cache.
+	Hits uint64
+	// Misses is the number of public keys which had to be decompressed.
+	Misses uint64
+	// Evictions is the number of public keys dropped to make room for new ones.
+	Evictions uint64
+	// Len is the number of public keys currently cached.
+	Len int
+	// Capacity is the maximum number of public keys the cache holds.
+	Capacity int
+	// Enabled reports whether the cache is in use.
+	Enabled bool
+}
+
+var (
+	cacheEnabled   atomic.Bool
+	cacheHits      atomic.Uint64
+	cacheMisses    atomic.Uint64
+	cacheEvictions atomic.Uint64
+)
+
+func onPubkeyEvict(_ [PubkeyLength]byte, _ PubKey) {
+	cacheEvictions.Add(1)
+}
+
+// SetPubKeyCacheSize sets the maximum number of public keys kept in the
+// cache, evicting the least recently used keys if needed, and enables the
+// cache. It is meant to be called at startup, before keys are decompressed.
+func SetPubKeyCacheSize(size int) error {
+	if size <= 0 {
+		return errors.New("cache size must be positive, use DisablePubKeyCache to disable the cache")
+	}
+	pubkeyCache.Resize(size)
+	cacheEnabled.Store(true)
+	return nil
+}
+
+// DisablePubKeyCache empties the public key cache and stops caching
+// decompressed public keys until SetPubKeyCacheSize is called.
+func DisablePubKeyCache() {
+	cacheEnabled.Store(false)
+	pubkeyCache.Purge()
+}
+
+// ClearPubKeyCache removes all public keys from the cache. The counters
+// reported by PubKeyCacheStats are left untouched.
+func ClearPubKeyCache() {
+	pubkeyCache.Purge()
+}
+
+// PubKeyCacheStats returns the current usage counters of the public key cache.
+func PubKeyCacheStats() CacheStats {
+	return CacheStats{
+		Hits:      cacheHits.Load(),
+		Misses:    cacheMisses.Load(),
+		Evictions: cacheEvictions.Load(),
+		Len:       pubkeyCache.Len(),
+		Capacity:  pubkeyCache.Cap(),
+		Enabled:   cacheEnabled.Load(),
+	}
+}
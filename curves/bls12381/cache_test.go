@@ -0,0 +1,107 @@
+package blst
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPubKeyCache_Stats(t *testing.T) {
+	defer EnableCaches()
+	require.NoError(t, SetPubKeyCacheSize(2))
+	ClearPubKeyCache()
+
+	rawKeys := make([][]byte, 0, 3)
+	for i := 0; i < 3; i++ {
+		priv, err := RandKey()
+		require.NoError(t, err)
+		rawKeys = append(rawKeys, priv.PublicKey().Marshal())
+	}
+
+	before := PubKeyCacheStats()
+	for _, raw := range rawKeys {
+		_, err := PublicKeyFromBytes(raw)
+		require.NoError(t, err)
+	}
+	// The most recently added key is still cached.
+	_, err := PublicKeyFromBytes(rawKeys[2])
+	require.NoError(t, err)
+
+	after := PubKeyCacheStats()
+	assert.True(t, after.Enabled)
+	assert.Equal(t, 2, after.Capacity)
+	assert.Equal(t, 2, after.Len)
+	assert.Equal(t, uint64(3), after.Misses-before.Misses)
+	assert.Equal(t, uint64(1), after.Hits-before.Hits)
+	assert.Equal(t, uint64(1), after.Evictions-before.Evictions)
+
+	ClearPubKeyCache()
+	assert.Equal(t, 0, PubKeyCacheStats().Len)
+}
+
+func TestPubKeyCache_Disable(t *testing.T) {
+	defer EnableCaches()
+	DisablePubKeyCache()
+
+	priv, err := RandKey()
+	require.NoError(t, err)
+	raw := priv.PublicKey().Marshal()
+
+	before := PubKeyCacheStats()
+	for i := 0; i < 2; i++ {
+		pub, err := PublicKeyFromBytes(raw)
+		require.NoError(t, err)
+		assert.Equal(t, raw, pub.Marshal())
+	}
+	after := PubKeyCacheStats()
+	assert.False(t, after.Enabled)
+	assert.Equal(t, 0, after.Len)
+	assert.Equal(t, before.Hits, after.Hits)
+	assert.Equal(t, before.Misses, after.Misses)
+}
+
+func TestSetPubKeyCacheSize_Invalid(t *testing.T) {
+	assert.Error(t, SetPubKeyCacheSize(0))
+	assert.Error(t, SetPubKeyCacheSize(-1))
+}
+
+func TestPubKeyCache_Concurrent(t *testing.T) {
+	defer EnableCaches()
+	require.NoError(t, SetPubKeyCacheSize(4))
+	ClearPubKeyCache()
+
+	rawKeys := make([][]byte, 0, 8)
+	for i := 0; i < 8; i++ {
+		priv, err := RandKey()
+		require.NoError(t, err)
+		rawKeys = append(rawKeys, priv.PublicKey().Marshal())
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				_, err := PublicKeyFromBytes(rawKeys[(i+j)%len(rawKeys)])
+				assert.NoError(t, err)
+				switch j % 50 {
+				case 10:
+					ClearPubKeyCache()
+				case 20:
+					assert.NoError(t, SetPubKeyCacheSize(2+i))
+				case 30:
+					PubKeyCacheStats()
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	stats := PubKeyCacheStats()
+	assert.LessOrEqual(t, stats.Len, stats.Capacity)
+	ClearPubKeyCache()
+	assert.Equal(t, 0, PubKeyCacheStats().Len)
+}
@@ -0,0 +1,104 @@
+package blst
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+const (
+	// PathPurpose is the purpose level of every EIP-2334 path.
+	PathPurpose = 12381
+	// PathCoinType is the EIP-2334 coin type reserved for Ethereum
+	// validator keys.
+	PathCoinType = 3600
+	// MinSeedLength is the minimal seed size accepted by DeriveMasterSK.
+	MinSeedLength = 32
+)
+
+// DeriveMasterSK derives the EIP-2333 master secret key from a seed, such as
+// the one obtained from a BIP-39 mnemonic. The seed must be at least
+// MinSeedLength bytes long.
+func DeriveMasterSK(seed []byte) (SecretKey, error) {
+	if len(seed) < MinSeedLength {
+		return nil, fmt.Errorf("seed must be at least %d bytes", MinSeedLength)
+	}
+	secKey := &bls12SecretKey{blst.DeriveMasterEip2333(seed)}
+	if secKey.isZero() {
+		return nil, errors.New("received secret key is zero")
+	}
+	return secKey, nil
+}
+
+// DeriveChildSK derives the EIP-2333 child secret key of parent at the given
+// index.
+func DeriveChildSK(parent SecretKey, index uint32) (SecretKey, error) {
+	parentKey, err := rawSecretKey(parent)
+	if err != nil {
+		return nil, err
+	}
+	secKey := &bls12SecretKey{parentKey.DeriveChildEip2333(index)}
+	if secKey.isZero() {
+		return nil, errors.New("received secret key is zero")
+	}
+	return secKey, nil
+}
+
+// ParsePath parses an EIP-2334 derivation path such as "m/12381/3600/0/0/0"
+// into its child indices. The master node "m" yields no indices; otherwise
+// the first index must be PathPurpose.
+func ParsePath(path string) ([]uint32, error) {
+	nodes := strings.Split(path, "/")
+	if nodes[0] != "m" {
+		return nil, fmt.Errorf("path %q must start with m", path)
+	}
+	indices := make([]uint32, 0, len(nodes)-1)
+	for _, node := range nodes[1:] {
+		index, err := strconv.ParseUint(node, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("path %q has invalid index %q", path, node)
+		}
+		indices = append(indices, uint32(index))
+	}
+	if len(indices) > 0 && indices[0] != PathPurpose {
+		return nil, fmt.Errorf("path %q must have purpose %d", path, PathPurpose)
+	}
+	return indices, nil
+}
+
+// DeriveKeyFromPath derives the secret key at the given EIP-2334 path from a
+// seed. The intermediate keys are zeroized.
+func DeriveKeyFromPath(seed []byte, path string) (SecretKey, error) {
+	indices, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	secKey, err := DeriveMasterSK(seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indices {
+		child, err := DeriveChildSK(secKey, index)
+		secKey.Zeroize()
+		if err != nil {
+			return nil, err
+		}
+		secKey = child
+	}
+	return secKey, nil
+}
+
+// WithdrawalKeyPath returns the EIP-2334 path of the withdrawal key of the
+// validator with the given account index.
+func WithdrawalKeyPath(account uint32) string {
+	return fmt.Sprintf("m/%d/%d/%d/0", PathPurpose, PathCoinType, account)
+}
+
+// SigningKeyPath returns the EIP-2334 path of the signing key of the
+// validator with the given account index.
+func SigningKeyPath(account uint32) string {
+	return fmt.Sprintf("m/%d/%d/%d/0/0", PathPurpose, PathCoinType, account)
+}
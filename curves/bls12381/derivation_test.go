@@ -0,0 +1,117 @@
+package blst_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	blst "github.com/cosmos/crypto/curves/bls12381"
+)
+
+// Test vectors from https://eips.ethereum.org/EIPS/eip-2333#test-cases
+var eip2333Vectors = []struct {
+	name       string
+	seed       string
+	masterSK   string
+	childIndex uint32
+	childSK    string
+}{
+	{
+		name:       "Case 0",
+		seed:       "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		masterSK:   "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+		childIndex: 0,
+		childSK:    "20397789859736650942317412262472558107875392172444076792671091975210932703118",
+	},
+	{
+		name:       "Case 1",
+		seed:       "3141592653589793238462643383279502884197169399375105820974944592",
+		masterSK:   "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+		childIndex: 3141592653,
+		childSK:    "25457201688850691947727629385191704516744796114925897962676248250929345014287",
+	},
+}
+
+func decimalToBytes32(t *testing.T, s string) []byte {
+	n, ok := new(big.Int).SetString(s, 10)
+	require.True(t, ok)
+	return n.FillBytes(make([]byte, 32))
+}
+
+func TestDeriveEIP2333(t *testing.T) {
+	for _, test := range eip2333Vectors {
+		t.Run(test.name, func(t *testing.T) {
+			seed, err := hex.DecodeString(test.seed)
+			require.NoError(t, err)
+
+			master, err := blst.DeriveMasterSK(seed)
+			require.NoError(t, err)
+			assert.Equal(t, decimalToBytes32(t, test.masterSK), master.Marshal())
+
+			child, err := blst.DeriveChildSK(master, test.childIndex)
+			require.NoError(t, err)
+			assert.Equal(t, decimalToBytes32(t, test.childSK), child.Marshal())
+		})
+	}
+}
+
+func TestDeriveMasterSK_ShortSeed(t *testing.T) {
+	_, err := blst.DeriveMasterSK(make([]byte, blst.MinSeedLength-1))
+	assert.ErrorContains(t, err, "seed must be at least 32 bytes")
+}
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want []uint32
+		err  string
+	}{
+		{name: "Master", path: "m", want: []uint32{}},
+		{name: "Signing", path: blst.SigningKeyPath(7), want: []uint32{12381, 3600, 7, 0, 0}},
+		{name: "Withdrawal", path: blst.WithdrawalKeyPath(7), want: []uint32{12381, 3600, 7, 0}},
+		{name: "No master", path: "12381/3600/0/0", err: "must start with m"},
+		{name: "Wrong purpose", path: "m/44/3600/0/0", err: "must have purpose 12381"},
+		{name: "Hardened", path: "m/12381'/3600/0/0", err: "has invalid index"},
+		{name: "Overflow", path: "m/12381/3600/4294967296", err: "has invalid index"},
+		{name: "Empty node", path: "m/12381//0", err: "has invalid index"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := blst.ParsePath(test.path)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestDeriveKeyFromPath(t *testing.T) {
+	seed, err := hex.DecodeString(eip2333Vectors[0].seed)
+	require.NoError(t, err)
+
+	master, err := blst.DeriveMasterSK(seed)
+	require.NoError(t, err)
+	key := master
+	for _, index := range []uint32{12381, 3600, 0, 0, 0} {
+		key, err = blst.DeriveChildSK(key, index)
+		require.NoError(t, err)
+	}
+
+	derived, err := blst.DeriveKeyFromPath(seed, blst.SigningKeyPath(0))
+	require.NoError(t, err)
+	assert.Equal(t, key.Marshal(), derived.Marshal())
+
+	derivedMaster, err := blst.DeriveKeyFromPath(seed, "m")
+	require.NoError(t, err)
+	assert.Equal(t, master.Marshal(), derivedMaster.Marshal())
+
+	_, err = blst.DeriveKeyFromPath(seed, "m/0")
+	assert.ErrorContains(t, err, "must have purpose 12381")
+}
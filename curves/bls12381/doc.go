@@ -2,5 +2,9 @@
 // BLS12-381 curve and signature scheme. This package exposes a public API for
 // verifying and aggregating BLS signatures used by Ethereum.
 //
+// The package-level functions implement the minimal-pubkey-size variant, with
+// public keys in G1 and signatures in G2. The minimal-signature-size variant
+// is available through NewScheme.
+//
 // This implementation uses the library written by Supranational, blst.
 package blst
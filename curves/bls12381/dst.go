@@ -0,0 +1,61 @@
+package blst
+
+import (
+	"errors"
+	"fmt"
+
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// MaxDSTLength is the maximum length of a domain separation tag, as defined
+// by RFC 9380.
+const MaxDSTLength = 255
+
+// DefaultDST returns a copy of the domain separation tag used by Sign and
+// Verify.
+func DefaultDST() []byte {
+	return append([]byte(nil), dst...)
+}
+
+// validateDST checks that an application-supplied domain separation tag
+// is usable with hash-to-curve.
+func validateDST(tag []byte) error {
+	if len(tag) == 0 {
+		return errors.New("domain separation tag must not be empty")
+	}
+	if len(tag) > MaxDSTLength {
+		return fmt.Errorf("domain separation tag must be at most %d bytes", MaxDSTLength)
+	}
+	return nil
+}
+
+// VerifySignatureWithDST verifies a single signature using public key and
+// message under an application-supplied domain separation tag.
+func VerifySignatureWithDST(sig []byte, msg [32]byte, pubKey PubKey, tag []byte) (bool, error) {
+	if err := validateDST(tag); err != nil {
+		return false, err
+	}
+	rSig, err := SignatureFromBytes(sig)
+	if err != nil {
+		return false, err
+	}
+	return rSig.VerifyWithDST(pubKey, msg[:], tag), nil
+}
+
+// HashToG1 hashes msg to a point in G1 with the hash_to_curve
+// BLS12381G1_XMD:SHA-256_SSWU_RO_ suite, and returns its compressed form.
+func HashToG1(msg []byte, tag []byte) ([]byte, error) {
+	if err := validateDST(tag); err != nil {
+		return nil, err
+	}
+	return blst.HashToG1(msg, tag).ToAffine().Compress(), nil
+}
+
+// HashToG2 hashes msg to a point in G2 with the hash_to_curve
+// BLS12381G2_XMD:SHA-256_SSWU_RO_ suite, and returns its compressed form.
+func HashToG2(msg []byte, tag []byte) ([]byte, error) {
+	if err := validateDST(tag); err != nil {
+		return nil, err
+	}
+	return blst.HashToG2(msg, tag).ToAffine().Compress(), nil
+}
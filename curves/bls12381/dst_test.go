@@ -0,0 +1,91 @@
+package blst
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignWithDST(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey()
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	chainA := []byte("COSMOS_CHAIN_A_BLS_SIG_")
+	chainB := []byte("COSMOS_CHAIN_B_BLS_SIG_")
+
+	sig, err := priv.SignWithDST(msg[:], chainA)
+	require.NoError(t, err)
+	assert.True(t, sig.VerifyWithDST(pub, msg[:], chainA), "Signature did not verify")
+	assert.False(t, sig.VerifyWithDST(pub, msg[:], chainB), "Signature replayed under another DST")
+	assert.False(t, sig.Verify(pub, msg[:]), "Signature replayed under the default DST")
+
+	valid, err := VerifySignatureWithDST(sig.Marshal(), msg, pub, chainA)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	// Signing under the default DST is the same as Sign.
+	defaultSig, err := priv.SignWithDST(msg[:], DefaultDST())
+	require.NoError(t, err)
+	assert.Equal(t, priv.Sign(msg[:]).Marshal(), defaultSig.Marshal())
+}
+
+func TestSignWithDST_MinSig(t *testing.T) {
+	scheme, err := NewScheme(MinSigSize)
+	require.NoError(t, err)
+	priv, err := RandKey()
+	require.NoError(t, err)
+	pub := scheme.PublicKey(priv)
+	msg := []byte("hello")
+	tag := []byte("COSMOS_CHAIN_A_BLS_SIG_")
+
+	sig, err := scheme.SignWithDST(priv, msg, tag)
+	require.NoError(t, err)
+	assert.True(t, sig.VerifyWithDST(pub, msg, tag), "Signature did not verify")
+	assert.False(t, sig.Verify(pub, msg), "Signature replayed under the default DST")
+}
+
+func TestValidateDST(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+
+	_, err = priv.SignWithDST(msg[:], nil)
+	assert.ErrorContains(t, err, "domain separation tag must not be empty")
+	_, err = priv.SignWithDST(msg[:], bytes.Repeat([]byte{'a'}, MaxDSTLength+1))
+	assert.ErrorContains(t, err, "domain separation tag must be at most 255 bytes")
+	_, err = VerifySignatureWithDST(priv.Sign(msg[:]).Marshal(), msg, priv.PublicKey(), nil)
+	assert.Error(t, err)
+	assert.False(t, priv.Sign(msg[:]).VerifyWithDST(priv.PublicKey(), msg[:], nil))
+	_, err = HashToG1(msg[:], nil)
+	assert.Error(t, err)
+	_, err = HashToG2(msg[:], nil)
+	assert.Error(t, err)
+}
+
+func TestHashToCurve(t *testing.T) {
+	// With a secret key of one, a signature is the hash of the message itself.
+	one := make([]byte, 32)
+	one[31] = 1
+	priv, err := SecretKeyFromBytes(one)
+	require.NoError(t, err)
+	msg := []byte("hello")
+
+	h2, err := HashToG2(msg, dst)
+	require.NoError(t, err)
+	assert.Len(t, h2, SignatureLength)
+	assert.Equal(t, priv.Sign(msg).Marshal(), h2)
+
+	scheme, err := NewScheme(MinSigSize)
+	require.NoError(t, err)
+	h1, err := HashToG1(msg, minSigDST)
+	require.NoError(t, err)
+	assert.Len(t, h1, MinSigSignatureLength)
+	assert.Equal(t, scheme.Sign(priv, msg).Marshal(), h1)
+
+	other, err := HashToG1(msg, []byte("ANOTHER_DST"))
+	require.NoError(t, err)
+	assert.NotEqual(t, h1, other)
+}
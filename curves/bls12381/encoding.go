@@ -0,0 +1,390 @@
+package blst
+
+import (
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	// PubkeyUncompressedLength is the byte length of an uncompressed public key.
+	PubkeyUncompressedLength = 96
+	// SignatureUncompressedLength is the byte length of an uncompressed signature.
+	SignatureUncompressedLength = 192
+
+	// MinSigPubkeyUncompressedLength is the byte length of an uncompressed
+	// minimal-signature-size public key.
+	MinSigPubkeyUncompressedLength = 192
+	// MinSigSignatureUncompressedLength is the byte length of an uncompressed
+	// minimal-signature-size signature.
+	MinSigSignatureUncompressedLength = 96
+)
+
+// Text and JSON encodings use standard base64 of the compressed bytes, the
+// same encoding internal/libs/json uses for the byte-slice keys of the other
+// curves.
+
+// MarshalText implements encoding.TextMarshaler.
+func (p *PublicKey) MarshalText() ([]byte, error) {
+	if p.p == nil {
+		return nil, errors.New("public key is empty")
+	}
+	return marshalText(p.Marshal()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The key is validated as
+// by PublicKeyFromBytes.
+func (p *PublicKey) UnmarshalText(text []byte) error {
+	bz, err := unmarshalText(text)
+	if err != nil {
+		return fmt.Errorf("could not decode public key: %w", err)
+	}
+	pubKey, err := PublicKeyFromBytes(bz)
+	if err != nil {
+		return err
+	}
+	p.p = pubKey.(*PublicKey).p
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PublicKey) MarshalJSON() ([]byte, error) {
+	return marshalJSON(p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PublicKey) UnmarshalJSON(bz []byte) error {
+	return unmarshalJSON(bz, p)
+}
+
+// MarshalUncompressed returns the 96-byte uncompressed encoding of the public
+// key, which is faster to decode than the compressed one.
+func (p *PublicKey) MarshalUncompressed() []byte {
+	return p.p.Serialize()
+}
+
+// PublicKeyFromUncompressedBytes creates a BLS public key from its uncompressed
+// encoding. The key is group checked but not cached.
+func PublicKeyFromUncompressedBytes(pubKey []byte) (PubKey, error) {
+	if len(pubKey) != PubkeyUncompressedLength {
+		return nil, fmt.Errorf("uncompressed public key must be %d bytes", PubkeyUncompressedLength)
+	}
+	if pubKey[0]&0x80 != 0 {
+		return nil, errors.New("public key is not uncompressed")
+	}
+	p := new(blstPublicKey).Deserialize(pubKey)
+	if p == nil {
+		return nil, errors.New("could not unmarshal bytes into public key")
+	}
+	// Subgroup and infinity check
+	if !p.KeyValidate() {
+		return nil, errors.New("publickey is infinite")
+	}
+	return &PublicKey{p: p}, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s *Signature) MarshalText() ([]byte, error) {
+	if s.s == nil {
+		return nil, errors.New("signature is empty")
+	}
+	return marshalText(s.Marshal()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The signature is
+// validated as by SignatureFromBytes.
+func (s *Signature) UnmarshalText(text []byte) error {
+	bz, err := unmarshalText(text)
+	if err != nil {
+		return fmt.Errorf("could not decode signature: %w", err)
+	}
+	sig, err := SignatureFromBytes(bz)
+	if err != nil {
+		return err
+	}
+	s.s = sig.(*Signature).s
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *Signature) MarshalJSON() ([]byte, error) {
+	return marshalJSON(s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *Signature) UnmarshalJSON(bz []byte) error {
+	return unmarshalJSON(bz, s)
+}
+
+// MarshalUncompressed returns the 192-byte uncompressed encoding of the
+// signature, which is faster to decode than the compressed one.
+func (s *Signature) MarshalUncompressed() []byte {
+	return s.s.Serialize()
+}
+
+// SignatureFromUncompressedBytes creates a BLS signature from its uncompressed
+// encoding. The signature is group checked.
+func SignatureFromUncompressedBytes(sig []byte) (SignatureI, error) {
+	if len(sig) != SignatureUncompressedLength {
+		return nil, fmt.Errorf("uncompressed signature must be %d bytes", SignatureUncompressedLength)
+	}
+	if sig[0]&0x80 != 0 {
+		return nil, errors.New("signature is not uncompressed")
+	}
+	signature := new(blstSignature).Deserialize(sig)
+	if signature == nil {
+		return nil, errors.New("could not unmarshal bytes into signature")
+	}
+	// Group check signature. Do not check for infinity since an aggregated signature
+	// could be infinite.
+	if !signature.SigValidate(false) {
+		return nil, errors.New("signature not in group")
+	}
+	return &Signature{s: signature}, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p *MinSigPublicKey) MarshalText() ([]byte, error) {
+	if p.p == nil {
+		return nil, errors.New("public key is empty")
+	}
+	return marshalText(p.Marshal()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The key is validated as
+// by the minimal-signature-size Scheme's PublicKeyFromBytes.
+func (p *MinSigPublicKey) UnmarshalText(text []byte) error {
+	bz, err := unmarshalText(text)
+	if err != nil {
+		return fmt.Errorf("could not decode public key: %w", err)
+	}
+	pubKey, err := minSigPublicKeyFromBytes(bz)
+	if err != nil {
+		return err
+	}
+	p.p = pubKey.p
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *MinSigPublicKey) MarshalJSON() ([]byte, error) {
+	return marshalJSON(p)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *MinSigPublicKey) UnmarshalJSON(bz []byte) error {
+	return unmarshalJSON(bz, p)
+}
+
+// MarshalUncompressed returns the 192-byte uncompressed encoding of the public
+// key, which is faster to decode than the compressed one.
+func (p *MinSigPublicKey) MarshalUncompressed() []byte {
+	return p.p.Serialize()
+}
+
+// MinSigPublicKeyFromUncompressedBytes creates a minimal-signature-size public
+// key from its uncompressed encoding. The key is group checked.
+func MinSigPublicKeyFromUncompressedBytes(pubKey []byte) (PubKey, error) {
+	if len(pubKey) != MinSigPubkeyUncompressedLength {
+		return nil, fmt.Errorf("uncompressed public key must be %d bytes", MinSigPubkeyUncompressedLength)
+	}
+	if pubKey[0]&0x80 != 0 {
+		return nil, errors.New("public key is not uncompressed")
+	}
+	p := new(blstMinSigPublicKey).Deserialize(pubKey)
+	if p == nil {
+		return nil, errors.New("could not unmarshal bytes into public key")
+	}
+	// Subgroup and infinity check
+	if !p.KeyValidate() {
+		return nil, errors.New("publickey is infinite")
+	}
+	return &MinSigPublicKey{p: p}, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s *MinSigSignature) MarshalText() ([]byte, error) {
+	if s.s == nil {
+		return nil, errors.New("signature is empty")
+	}
+	return marshalText(s.Marshal()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The signature is
+// validated as by the minimal-signature-size Scheme's SignatureFromBytes.
+func (s *MinSigSignature) UnmarshalText(text []byte) error {
+	bz, err := unmarshalText(text)
+	if err != nil {
+		return fmt.Errorf("could not decode signature: %w", err)
+	}
+	sig, err := minSigSignatureFromBytes(bz)
+	if err != nil {
+		return err
+	}
+	s.s = sig.s
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *MinSigSignature) MarshalJSON() ([]byte, error) {
+	return marshalJSON(s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *MinSigSignature) UnmarshalJSON(bz []byte) error {
+	return unmarshalJSON(bz, s)
+}
+
+// MarshalUncompressed returns the 96-byte uncompressed encoding of the
+// signature, which is faster to decode than the compressed one.
+func (s *MinSigSignature) MarshalUncompressed() []byte {
+	return s.s.Serialize()
+}
+
+// MinSigSignatureFromUncompressedBytes creates a minimal-signature-size
+// signature from its uncompressed encoding. The signature is group checked.
+func MinSigSignatureFromUncompressedBytes(sig []byte) (SignatureI, error) {
+	if len(sig) != MinSigSignatureUncompressedLength {
+		return nil, fmt.Errorf("uncompressed signature must be %d bytes", MinSigSignatureUncompressedLength)
+	}
+	if sig[0]&0x80 != 0 {
+		return nil, errors.New("signature is not uncompressed")
+	}
+	signature := new(blstMinSigSignature).Deserialize(sig)
+	if signature == nil {
+		return nil, errors.New("could not unmarshal bytes into signature")
+	}
+	// Group check signature. Do not check for infinity since an aggregated signature
+	// could be infinite.
+	if !signature.SigValidate(false) {
+		return nil, errors.New("signature not in group")
+	}
+	return &MinSigSignature{s: signature}, nil
+}
+
+// MarshalJSON implements json.Marshaler. Registered with
+// internal/libs/json as PubKeyName.
+func (p PubKeyAdapter) MarshalJSON() ([]byte, error) {
+	if p.key == nil {
+		return nil, errors.New("public key is empty")
+	}
+	return json.Marshal(p.Bytes())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PubKeyAdapter) UnmarshalJSON(bz []byte) error {
+	var raw []byte
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return err
+	}
+	key, err := PubKeyAdapterFromBytes(raw)
+	if err != nil {
+		return err
+	}
+	*p = key
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. Registered with
+// internal/libs/json as PrivKeyName.
+func (s PrivKeyAdapter) MarshalJSON() ([]byte, error) {
+	if s.key == nil {
+		return nil, errors.New("secret key is empty")
+	}
+	return json.Marshal(s.Bytes())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *PrivKeyAdapter) UnmarshalJSON(bz []byte) error {
+	var raw []byte
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return err
+	}
+	key, err := PrivKeyAdapterFromBytes(raw)
+	if err != nil {
+		return err
+	}
+	*s = key
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. Registered with
+// internal/libs/json as MinSigPubKeyName.
+func (p MinSigPubKeyAdapter) MarshalJSON() ([]byte, error) {
+	if p.key == nil {
+		return nil, errors.New("public key is empty")
+	}
+	return json.Marshal(p.Bytes())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *MinSigPubKeyAdapter) UnmarshalJSON(bz []byte) error {
+	var raw []byte
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return err
+	}
+	key, err := MinSigPubKeyAdapterFromBytes(raw)
+	if err != nil {
+		return err
+	}
+	*p = key
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. Registered with
+// internal/libs/json as MinSigPrivKeyName.
+func (s MinSigPrivKeyAdapter) MarshalJSON() ([]byte, error) {
+	if s.key == nil {
+		return nil, errors.New("secret key is empty")
+	}
+	return json.Marshal(s.Bytes())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *MinSigPrivKeyAdapter) UnmarshalJSON(bz []byte) error {
+	var raw []byte
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return err
+	}
+	key, err := MinSigPrivKeyAdapterFromBytes(raw)
+	if err != nil {
+		return err
+	}
+	*s = key
+	return nil
+}
+
+func marshalText(bz []byte) []byte {
+	text := make([]byte, base64.StdEncoding.EncodedLen(len(bz)))
+	base64.StdEncoding.Encode(text, bz)
+	return text
+}
+
+func unmarshalText(text []byte) ([]byte, error) {
+	bz := make([]byte, base64.StdEncoding.DecodedLen(len(text)))
+	n, err := base64.StdEncoding.Strict().Decode(bz, text)
+	if err != nil {
+		return nil, err
+	}
+	return bz[:n], nil
+}
+
+// marshalJSON encodes a TextMarshaler as a JSON string.
+func marshalJSON(v encoding.TextMarshaler) ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// unmarshalJSON decodes a JSON string into a TextUnmarshaler.
+func unmarshalJSON(bz []byte, v encoding.TextUnmarshaler) error {
+	var text string
+	if err := json.Unmarshal(bz, &text); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(text))
+}
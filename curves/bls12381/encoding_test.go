@@ -0,0 +1,203 @@
+package blst_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	blst "github.com/cosmos/crypto/curves/bls12381"
+	cmtjson "github.com/cosmos/crypto/internal/libs/json"
+	"github.com/cosmos/crypto/types"
+)
+
+func TestPublicKey_TextJSON(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().(*blst.PublicKey)
+
+	text, err := pub.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(pub.Marshal()), string(text))
+
+	decoded := new(blst.PublicKey)
+	require.NoError(t, decoded.UnmarshalText(text))
+	assert.True(t, pub.Equals(decoded))
+
+	bz, err := json.Marshal(pub)
+	require.NoError(t, err)
+	assert.Equal(t, `"`+string(text)+`"`, string(bz))
+	decoded = new(blst.PublicKey)
+	require.NoError(t, json.Unmarshal(bz, decoded))
+	assert.True(t, pub.Equals(decoded))
+
+	assert.Error(t, decoded.UnmarshalText([]byte("not base64!")))
+	assert.Error(t, decoded.UnmarshalText([]byte(base64.StdEncoding.EncodeToString(make([]byte, blst.PubkeyLength)))))
+	_, err = new(blst.PublicKey).MarshalText()
+	assert.Error(t, err)
+}
+
+func TestSignature_TextJSON(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte("hello")).(*blst.Signature)
+
+	bz, err := json.Marshal(sig)
+	require.NoError(t, err)
+	decoded := new(blst.Signature)
+	require.NoError(t, json.Unmarshal(bz, decoded))
+	assert.Equal(t, sig.Marshal(), decoded.Marshal())
+	assert.True(t, decoded.Verify(priv.PublicKey(), []byte("hello")))
+
+	assert.Error(t, json.Unmarshal([]byte(`"AAAA"`), decoded))
+	assert.Error(t, json.Unmarshal([]byte(`42`), decoded))
+}
+
+func TestUncompressed(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey().(*blst.PublicKey)
+	sig := priv.Sign([]byte("hello")).(*blst.Signature)
+
+	rawPub := pub.MarshalUncompressed()
+	assert.Len(t, rawPub, blst.PubkeyUncompressedLength)
+	decodedPub, err := blst.PublicKeyFromUncompressedBytes(rawPub)
+	require.NoError(t, err)
+	assert.True(t, pub.Equals(decodedPub))
+
+	rawSig := sig.MarshalUncompressed()
+	assert.Len(t, rawSig, blst.SignatureUncompressedLength)
+	decodedSig, err := blst.SignatureFromUncompressedBytes(rawSig)
+	require.NoError(t, err)
+	assert.Equal(t, sig.Marshal(), decodedSig.Marshal())
+
+	_, err = blst.PublicKeyFromUncompressedBytes(pub.Marshal())
+	assert.ErrorContains(t, err, "uncompressed public key must be 96 bytes")
+	_, err = blst.SignatureFromUncompressedBytes(sig.Marshal())
+	assert.ErrorContains(t, err, "uncompressed signature must be 192 bytes")
+
+	// A point off the curve is rejected.
+	rawPub[len(rawPub)-1] ^= 1
+	_, err = blst.PublicKeyFromUncompressedBytes(rawPub)
+	assert.Error(t, err)
+	rawSig[len(rawSig)-1] ^= 1
+	_, err = blst.SignatureFromUncompressedBytes(rawSig)
+	assert.Error(t, err)
+}
+
+func TestAdapter_JSON(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	privKey := blst.NewPrivKeyAdapter(priv)
+	pubKey := privKey.PubKey()
+
+	bz, err := cmtjson.Marshal(pubKey)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"`+blst.PubKeyName+`","value":"`+base64.StdEncoding.EncodeToString(pubKey.Bytes())+`"}`, string(bz))
+
+	var decoded types.PubKey
+	require.NoError(t, cmtjson.Unmarshal(bz, &decoded))
+	assert.True(t, pubKey.Equals(decoded))
+
+	bz, err = cmtjson.Marshal(privKey)
+	require.NoError(t, err)
+	var decodedPriv blst.PrivKeyAdapter
+	require.NoError(t, cmtjson.Unmarshal(bz, &decodedPriv))
+	assert.True(t, privKey.Equals(decodedPriv))
+
+	assert.Error(t, cmtjson.Unmarshal([]byte(`{"type":"`+blst.PubKeyName+`","value":"AAAA"}`), &decoded))
+}
+
+func TestMinSigAdapter_JSON(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	privKey := blst.NewMinSigPrivKeyAdapter(priv)
+	pubKey := privKey.PubKey()
+
+	bz, err := cmtjson.Marshal(pubKey)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"`+blst.MinSigPubKeyName+`","value":"`+base64.StdEncoding.EncodeToString(pubKey.Bytes())+`"}`, string(bz))
+
+	var decoded types.PubKey
+	require.NoError(t, cmtjson.Unmarshal(bz, &decoded))
+	assert.True(t, pubKey.Equals(decoded))
+
+	bz, err = cmtjson.Marshal(privKey)
+	require.NoError(t, err)
+	var decodedPriv blst.MinSigPrivKeyAdapter
+	require.NoError(t, cmtjson.Unmarshal(bz, &decodedPriv))
+	assert.True(t, privKey.Equals(decodedPriv))
+}
+
+func TestMinSig_TextJSON(t *testing.T) {
+	scheme, err := blst.NewScheme(blst.MinSigSize)
+	require.NoError(t, err)
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := scheme.PublicKey(priv).(*blst.MinSigPublicKey)
+	sig := scheme.Sign(priv, []byte("hello")).(*blst.MinSigSignature)
+
+	text, err := pub.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(pub.Marshal()), string(text))
+
+	bz, err := json.Marshal(pub)
+	require.NoError(t, err)
+	assert.Equal(t, `"`+string(text)+`"`, string(bz))
+	decodedPub := new(blst.MinSigPublicKey)
+	require.NoError(t, json.Unmarshal(bz, decodedPub))
+	assert.True(t, pub.Equals(decodedPub))
+
+	bz, err = json.Marshal(sig)
+	require.NoError(t, err)
+	decodedSig := new(blst.MinSigSignature)
+	require.NoError(t, json.Unmarshal(bz, decodedSig))
+	assert.Equal(t, sig.Marshal(), decodedSig.Marshal())
+	assert.True(t, decodedSig.Verify(pub, []byte("hello")))
+
+	// Encodings of the other variant are rejected.
+	minPubText, err := priv.PublicKey().(*blst.PublicKey).MarshalText()
+	require.NoError(t, err)
+	assert.Error(t, decodedPub.UnmarshalText(minPubText))
+	assert.Error(t, json.Unmarshal([]byte(`"AAAA"`), decodedSig))
+	_, err = new(blst.MinSigPublicKey).MarshalText()
+	assert.Error(t, err)
+	_, err = new(blst.MinSigSignature).MarshalText()
+	assert.Error(t, err)
+}
+
+func TestMinSig_Uncompressed(t *testing.T) {
+	scheme, err := blst.NewScheme(blst.MinSigSize)
+	require.NoError(t, err)
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := scheme.PublicKey(priv).(*blst.MinSigPublicKey)
+	sig := scheme.Sign(priv, []byte("hello")).(*blst.MinSigSignature)
+
+	rawPub := pub.MarshalUncompressed()
+	assert.Len(t, rawPub, blst.MinSigPubkeyUncompressedLength)
+	decodedPub, err := blst.MinSigPublicKeyFromUncompressedBytes(rawPub)
+	require.NoError(t, err)
+	assert.True(t, pub.Equals(decodedPub))
+
+	rawSig := sig.MarshalUncompressed()
+	assert.Len(t, rawSig, blst.MinSigSignatureUncompressedLength)
+	decodedSig, err := blst.MinSigSignatureFromUncompressedBytes(rawSig)
+	require.NoError(t, err)
+	assert.Equal(t, sig.Marshal(), decodedSig.Marshal())
+
+	_, err = blst.MinSigPublicKeyFromUncompressedBytes(pub.Marshal())
+	assert.ErrorContains(t, err, "uncompressed public key must be 192 bytes")
+	_, err = blst.MinSigSignatureFromUncompressedBytes(sig.Marshal())
+	assert.ErrorContains(t, err, "uncompressed signature must be 96 bytes")
+
+	// A point off the curve is rejected.
+	rawPub[len(rawPub)-1] ^= 1
+	_, err = blst.MinSigPublicKeyFromUncompressedBytes(rawPub)
+	assert.Error(t, err)
+	rawSig[len(rawSig)-1] ^= 1
+	_, err = blst.MinSigSignatureFromUncompressedBytes(rawSig)
+	assert.Error(t, err)
+}
@@ -2,12 +2,14 @@ package blst
 
 // Note: These functions are for tests to access private globals, such as pubkeyCache.
 
-// DisableCaches sets the cache sizes to 0.
+// DisableCaches disables the public key cache.
 func DisableCaches() {
-	pubkeyCache.Resize(0)
+	DisablePubKeyCache()
 }
 
 // EnableCaches sets the cache sizes to the default values.
 func EnableCaches() {
-	pubkeyCache.Resize(maxKeys)
+	if err := SetPubKeyCacheSize(maxKeys); err != nil {
+		panic(err)
+	}
 }
@@ -16,10 +16,10 @@ func init() {
 		maxProcs = 1
 	}
 	blst.SetMaxProcs(maxProcs)
-	onEvict := func(_ [48]byte, _ PubKey) {}
-	keysCache, err := cache.NewLRU(maxKeys, onEvict)
+	keysCache, err := cache.NewLRU(maxKeys, onPubkeyEvict)
 	if err != nil {
 		panic(fmt.Sprintf("Could not initiate public keys cache: %v", err))
 	}
 	pubkeyCache = keysCache
+	cacheEnabled.Store(true)
 }
@@ -9,13 +9,27 @@ type PubKey interface {
 // SignatureI represents a BLS signature.
 type SignatureI interface {
 	Verify(pubKey PubKey, msg []byte) bool
+	VerifyWithDST(pubKey PubKey, msg []byte, dst []byte) bool
+	AggregateVerify(pubKeys []PubKey, msgs [][32]byte) bool
+	FastAggregateVerify(pubKeys []PubKey, msg [32]byte) bool
 	Marshal() []byte
 	Copy() SignatureI
 }
 
 // SecretKey represents a BLS secret or private key.
+//
+// Zeroize wipes the key from memory and Destroy is an alias of it. Once a key
+// has been zeroized, every operation on it fails: Marshal returns nil,
+// SignWithDST returns ErrSecretKeyZeroized, and PublicKey, Sign and
+// ProvePossession, which cannot return an error, panic with
+// ErrSecretKeyZeroized.
 type SecretKey interface {
 	PublicKey() PubKey
 	Sign(msg []byte) SignatureI
+	SignWithDST(msg []byte, dst []byte) (SignatureI, error)
+	ProvePossession() SignatureI
 	Marshal() []byte
+	Zeroize()
+	Destroy()
+	IsZeroized() bool
 }
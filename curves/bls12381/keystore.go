@@ -0,0 +1,325 @@
+package blst
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/cosmos/crypto/kdf"
+	"github.com/cosmos/crypto/random"
+)
+
+const (
+	// KeystoreVersion is the EIP-2335 keystore version.
+	KeystoreVersion = 4
+
+	// KDFScrypt selects scrypt as the keystore key derivation function.
+	KDFScrypt = "scrypt"
+	// KDFPBKDF2 selects PBKDF2 as the keystore key derivation function.
+	KDFPBKDF2 = "pbkdf2"
+
+	checksumSHA256 = "sha256"
+	cipherAES128   = "aes-128-ctr"
+	prfHMACSHA256  = "hmac-sha256"
+
+	keystoreDKLen    = 32
+	keystoreSaltLen  = 32
+	scryptN          = 1 << 18
+	scryptR          = 8
+	scryptP          = 1
+	pbkdf2Iterations = 1 << 18
+)
+
+var (
+	ErrKeystoreChecksum = errors.New("keystore: checksum mismatch, invalid password")
+	ErrKeystoreVersion  = errors.New("keystore: unsupported version")
+)
+
+// Keystore is an EIP-2335 JSON keystore holding an encrypted BLS secret key.
+type Keystore struct {
+	Crypto      KeystoreCrypto `json:"crypto"`
+	Description string         `json:"description"`
+	Pubkey      string         `json:"pubkey"`
+	Path        string         `json:"path"`
+	UUID        string         `json:"uuid"`
+	Version     int            `json:"version"`
+}
+
+// KeystoreCrypto holds the three EIP-2335 modules of a keystore.
+type KeystoreCrypto struct {
+	KDF      KeystoreModule `json:"kdf"`
+	Checksum KeystoreModule `json:"checksum"`
+	Cipher   KeystoreModule `json:"cipher"`
+}
+
+// KeystoreModule is a single EIP-2335 module: a function name, its
+// function-specific parameters and a hex encoded message.
+type KeystoreModule struct {
+	Function string          `json:"function"`
+	Params   json.RawMessage `json:"params"`
+	Message  string          `json:"message"`
+}
+
+type scryptParams struct {
+	DKLen int    `json:"dklen"`
+	N     int    `json:"n"`
+	P     int    `json:"p"`
+	R     int    `json:"r"`
+	Salt  string `json:"salt"`
+}
+
+type pbkdf2Params struct {
+	DKLen int    `json:"dklen"`
+	C     int    `json:"c"`
+	PRF   string `json:"prf"`
+	Salt  string `json:"salt"`
+}
+
+type cipherParams struct {
+	IV string `json:"iv"`
+}
+
+// NewKeystore encrypts the secret key with the password into an EIP-2335
+// keystore. kdf must be KDFScrypt or KDFPBKDF2; path is the EIP-2334 path the
+// key was derived at, and may be empty.
+func NewKeystore(secKey SecretKey, password string, path string, kdf string) (*Keystore, error) {
+	salt := random.CRandBytes(keystoreSaltLen)
+	kdfModule := KeystoreModule{Function: kdf, Message: ""}
+	var err error
+	switch kdf {
+	case KDFScrypt:
+		kdfModule.Params, err = json.Marshal(scryptParams{
+			DKLen: keystoreDKLen, N: scryptN, P: scryptP, R: scryptR, Salt: hex.EncodeToString(salt),
+		})
+	case KDFPBKDF2:
+		kdfModule.Params, err = json.Marshal(pbkdf2Params{
+			DKLen: keystoreDKLen, C: pbkdf2Iterations, PRF: prfHMACSHA256, Salt: hex.EncodeToString(salt),
+		})
+	default:
+		return nil, fmt.Errorf("keystore: unsupported kdf %q", kdf)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if secKey.IsZeroized() {
+		return nil, ErrSecretKeyZeroized
+	}
+	decryptionKey, err := kdfModule.decryptionKey(password)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(decryptionKey)
+
+	iv := random.CRandBytes(aes.BlockSize)
+	secret := secKey.Marshal()
+	defer clear(secret)
+	cipherText, err := aes128CTR(decryptionKey[:16], iv, secret)
+	if err != nil {
+		return nil, err
+	}
+	cipherParamsBz, err := json.Marshal(cipherParams{IV: hex.EncodeToString(iv)})
+	if err != nil {
+		return nil, err
+	}
+
+	uuid, err := newUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Keystore{
+		Crypto: KeystoreCrypto{
+			KDF: kdfModule,
+			Checksum: KeystoreModule{
+				Function: checksumSHA256,
+				Params:   json.RawMessage("{}"),
+				Message:  hex.EncodeToString(keystoreChecksum(decryptionKey, cipherText)),
+			},
+			Cipher: KeystoreModule{
+				Function: cipherAES128,
+				Params:   cipherParamsBz,
+				Message:  hex.EncodeToString(cipherText),
+			},
+		},
+		Pubkey:  hex.EncodeToString(secKey.PublicKey().Marshal()),
+		Path:    path,
+		UUID:    uuid,
+		Version: KeystoreVersion,
+	}, nil
+}
+
+// Decrypt decrypts the secret key held in the keystore with the password.
+// ErrKeystoreChecksum is returned if the password is wrong.
+func (ks *Keystore) Decrypt(password string) (SecretKey, error) {
+	if ks.Version != KeystoreVersion {
+		return nil, ErrKeystoreVersion
+	}
+	if ks.Crypto.Checksum.Function != checksumSHA256 {
+		return nil, fmt.Errorf("keystore: unsupported checksum %q", ks.Crypto.Checksum.Function)
+	}
+	if ks.Crypto.Cipher.Function != cipherAES128 {
+		return nil, fmt.Errorf("keystore: unsupported cipher %q", ks.Crypto.Cipher.Function)
+	}
+
+	decryptionKey, err := ks.Crypto.KDF.decryptionKey(password)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(decryptionKey)
+	cipherText, err := hex.DecodeString(ks.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: invalid cipher message: %w", err)
+	}
+	checksum, err := hex.DecodeString(ks.Crypto.Checksum.Message)
+	if err != nil {
+		return nil, fmt.Errorf("keystore: invalid checksum message: %w", err)
+	}
+	if subtle.ConstantTimeCompare(checksum, keystoreChecksum(decryptionKey, cipherText)) != 1 {
+		return nil, ErrKeystoreChecksum
+	}
+
+	var params cipherParams
+	if err := json.Unmarshal(ks.Crypto.Cipher.Params, &params); err != nil {
+		return nil, fmt.Errorf("keystore: invalid cipher params: %w", err)
+	}
+	iv, err := hex.DecodeString(params.IV)
+	if err != nil || len(iv) != aes.BlockSize {
+		return nil, errors.New("keystore: invalid cipher iv")
+	}
+	secret, err := aes128CTR(decryptionKey[:16], iv, cipherText)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(secret)
+
+	secKey, err := SecretKeyFromBytes(secret)
+	if err != nil {
+		return nil, err
+	}
+	if ks.Pubkey != "" {
+		pubKey, err := hex.DecodeString(ks.Pubkey)
+		if err != nil || !bytes.Equal(pubKey, secKey.PublicKey().Marshal()) {
+			secKey.Zeroize()
+			return nil, errors.New("keystore: public key does not match secret key")
+		}
+	}
+	return secKey, nil
+}
+
+// decryptionKey runs the key derivation function described by the module on
+// the processed password.
+func (m KeystoreModule) decryptionKey(password string) ([]byte, error) {
+	pass := processPassword(password)
+	defer clear(pass)
+	switch m.Function {
+	case KDFScrypt:
+		var params scryptParams
+		if err := json.Unmarshal(m.Params, &params); err != nil {
+			return nil, fmt.Errorf("keystore: invalid kdf params: %w", err)
+		}
+		salt, err := hex.DecodeString(params.Salt)
+		if err != nil {
+			return nil, fmt.Errorf("keystore: invalid kdf salt: %w", err)
+		}
+		if params.DKLen != keystoreDKLen {
+			return nil, fmt.Errorf("keystore: dklen must be %d", keystoreDKLen)
+		}
+		if params.N < 2 || params.N&(params.N-1) != 0 {
+			return nil, errors.New("keystore: scrypt n must be a power of two")
+		}
+		logN := uint8(bits.Len(uint(params.N)) - 1)
+		if err := checkKDFParams(kdf.ScryptParams{LogN: logN, R: params.R, P: params.P}); err != nil {
+			return nil, err
+		}
+		return scrypt.Key(pass, salt, params.N, params.R, params.P, params.DKLen)
+	case KDFPBKDF2:
+		var params pbkdf2Params
+		if err := json.Unmarshal(m.Params, &params); err != nil {
+			return nil, fmt.Errorf("keystore: invalid kdf params: %w", err)
+		}
+		if params.PRF != prfHMACSHA256 {
+			return nil, fmt.Errorf("keystore: unsupported prf %q", params.PRF)
+		}
+		salt, err := hex.DecodeString(params.Salt)
+		if err != nil {
+			return nil, fmt.Errorf("keystore: invalid kdf salt: %w", err)
+		}
+		if params.DKLen != keystoreDKLen {
+			return nil, fmt.Errorf("keystore: dklen must be %d", keystoreDKLen)
+		}
+		if err := checkKDFParams(kdf.PBKDF2Params{Iterations: params.C}); err != nil {
+			return nil, err
+		}
+		return pbkdf2.Key(pass, salt, params.C, params.DKLen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("keystore: unsupported kdf %q", m.Function)
+	}
+}
+
+// checkKDFParams checks the parameters read from a keystore against
+// kdf.DefaultLimits, so that a crafted file cannot force huge allocations or
+// unbounded work. The limits admit the parameters written by NewKeystore and
+// other EIP-2335 implementations.
+func checkKDFParams(params kdf.Params) error {
+	if err := kdf.DefaultLimits.Check(params); err != nil {
+		return fmt.Errorf("keystore: %w", err)
+	}
+	return nil
+}
+
+// processPassword normalizes the password to NFKD and strips the C0, C1 and
+// Delete control codes, as required by EIP-2335.
+func processPassword(password string) []byte {
+	normalized := norm.NFKD.String(password)
+	var b strings.Builder
+	for _, r := range normalized {
+		if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return []byte(b.String())
+}
+
+// keystoreChecksum is SHA256(DK[16:32] | cipher_message).
+func keystoreChecksum(decryptionKey, cipherText []byte) []byte {
+	h := sha256.New()
+	h.Write(decryptionKey[16:32])
+	h.Write(cipherText)
+	return h.Sum(nil)
+}
+
+func aes128CTR(key, iv, in []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(in))
+	cipher.NewCTR(block, iv).XORKeyStream(out, in)
+	return out, nil
+}
+
+// newUUID returns a random RFC 4122 version 4 UUID.
+func newUUID() (string, error) {
+	var u [16]byte
+	if _, err := io.ReadFull(random.CReader(), u[:]); err != nil {
+		return "", err
+	}
+	u[6] = (u[6] & 0x0f) | 0x40
+	u[8] = (u[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:]), nil
+}
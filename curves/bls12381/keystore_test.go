@@ -0,0 +1,184 @@
+package blst_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	blst "github.com/cosmos/crypto/curves/bls12381"
+)
+
+// Test vectors from https://eips.ethereum.org/EIPS/eip-2335#test-cases
+const (
+	keystorePassword = "𝔱𝔢𝔰𝔱𝔭𝔞𝔰𝔰𝔴𝔬𝔯𝔡🔑"
+	keystoreSecret   = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+
+	scryptKeystore = `{
+    "crypto": {
+        "kdf": {
+            "function": "scrypt",
+            "params": {
+                "dklen": 32,
+                "n": 262144,
+                "p": 1,
+                "r": 8,
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "d2217fe5f3e9a1e34581ef8a78f7c9928e436d36dacc5e846690a5581e8ea484"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "06ae90d55fe0a6e9c5c3bc5b170827b2e5cce3929ed3f116c2811e6366dfe20f"
+        }
+    },
+    "description": "This is a test keystore that uses scrypt to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/3141592653/589793238",
+    "uuid": "1d85ae20-35c5-4611-98e8-aa14a633906f",
+    "version": 4
+}`
+
+	pbkdf2Keystore = `{
+    "crypto": {
+        "kdf": {
+            "function": "pbkdf2",
+            "params": {
+                "dklen": 32,
+                "c": 262144,
+                "prf": "hmac-sha256",
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+        }
+    },
+    "description": "This is a test keystore that uses PBKDF2 to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/0/0",
+    "uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+    "version": 4
+}`
+)
+
+func TestKeystoreDecrypt_Vectors(t *testing.T) {
+	tests := []struct {
+		name     string
+		keystore string
+	}{
+		{name: "scrypt", keystore: scryptKeystore},
+		{name: "pbkdf2", keystore: pbkdf2Keystore},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if testing.Short() && test.name == "scrypt" {
+				t.Skip("scrypt test vector requires 256 MiB of memory")
+			}
+			var ks blst.Keystore
+			require.NoError(t, json.Unmarshal([]byte(test.keystore), &ks))
+
+			secKey, err := ks.Decrypt(keystorePassword)
+			require.NoError(t, err)
+			assert.Equal(t, keystoreSecret, hex.EncodeToString(secKey.Marshal()))
+
+			_, err = ks.Decrypt("wrong password")
+			assert.ErrorIs(t, err, blst.ErrKeystoreChecksum)
+		})
+	}
+}
+
+func TestKeystoreRoundTrip(t *testing.T) {
+	for _, kdf := range []string{blst.KDFScrypt, blst.KDFPBKDF2} {
+		t.Run(kdf, func(t *testing.T) {
+			if testing.Short() && kdf == blst.KDFScrypt {
+				t.Skip("scrypt keystores require 256 MiB of memory")
+			}
+			priv, err := blst.RandKey()
+			require.NoError(t, err)
+
+			ks, err := blst.NewKeystore(priv, keystorePassword, blst.SigningKeyPath(0), kdf)
+			require.NoError(t, err)
+			assert.Equal(t, blst.KeystoreVersion, ks.Version)
+			assert.Equal(t, hex.EncodeToString(priv.PublicKey().Marshal()), ks.Pubkey)
+			assert.Len(t, ks.UUID, 36)
+
+			bz, err := json.Marshal(ks)
+			require.NoError(t, err)
+			var decoded blst.Keystore
+			require.NoError(t, json.Unmarshal(bz, &decoded))
+
+			secKey, err := decoded.Decrypt(keystorePassword)
+			require.NoError(t, err)
+			assert.Equal(t, priv.Marshal(), secKey.Marshal())
+		})
+	}
+}
+
+func TestKeystore_InvalidInput(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+
+	_, err = blst.NewKeystore(priv, "password", "", "argon2")
+	assert.ErrorContains(t, err, `unsupported kdf "argon2"`)
+
+	ks, err := blst.NewKeystore(priv, "password", "", blst.KDFPBKDF2)
+	require.NoError(t, err)
+
+	ks.Version = 3
+	_, err = ks.Decrypt("password")
+	assert.ErrorIs(t, err, blst.ErrKeystoreVersion)
+	ks.Version = blst.KeystoreVersion
+
+	// Control codes are stripped from passwords.
+	_, err = ks.Decrypt("pass\x7fword\n")
+	assert.NoError(t, err)
+}
+
+func TestKeystore_KDFLimits(t *testing.T) {
+	tests := []struct {
+		function string
+		params   string
+		err      string
+	}{
+		{blst.KDFScrypt, `{"dklen":32,"n":536870912,"r":8,"p":1,"salt":"00"}`, "scrypt memory"},
+		{blst.KDFScrypt, `{"dklen":32,"n":524288,"r":8,"p":1,"salt":"00"}`, "exceed the limits"},
+		{blst.KDFScrypt, `{"dklen":32,"n":1000,"r":8,"p":1,"salt":"00"}`, "scrypt n"},
+		{blst.KDFScrypt, `{"dklen":32,"n":1024,"r":536870912,"p":1,"salt":"00"}`, "scrypt memory"},
+		{blst.KDFScrypt, `{"dklen":32,"n":1024,"r":8,"p":0,"salt":"00"}`, "scrypt r must"},
+		{blst.KDFScrypt, `{"dklen":32,"n":1024,"r":8,"p":8,"salt":"00"}`, "exceed the limits"},
+		{blst.KDFScrypt, `{"dklen":1073741824,"n":1024,"r":8,"p":1,"salt":"00"}`, "dklen"},
+		{blst.KDFPBKDF2, `{"dklen":32,"c":2147483647,"prf":"hmac-sha256","salt":"00"}`, "pbkdf2 iterations"},
+		{blst.KDFPBKDF2, `{"dklen":32,"c":4000000,"prf":"hmac-sha256","salt":"00"}`, "exceed the limits"},
+		{blst.KDFPBKDF2, `{"dklen":32,"c":0,"prf":"hmac-sha256","salt":"00"}`, "pbkdf2 iterations"},
+		{blst.KDFPBKDF2, `{"dklen":64,"c":1024,"prf":"hmac-sha256","salt":"00"}`, "dklen"},
+	}
+	for _, test := range tests {
+		var ks blst.Keystore
+		require.NoError(t, json.Unmarshal([]byte(scryptKeystore), &ks))
+		ks.Crypto.KDF.Function = test.function
+		ks.Crypto.KDF.Params = json.RawMessage(test.params)
+		_, err := ks.Decrypt(keystorePassword)
+		assert.ErrorContains(t, err, test.err, test.params)
+	}
+}
@@ -0,0 +1,174 @@
+package blst
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	MinSigSignatureLength = 48 // MinSigSignatureLength defines the byte length of a G1 signature.
+	MinSigPubkeyLength    = 96 // MinSigPubkeyLength defines the byte length of a G2 public key.
+)
+
+var (
+	minSigDST    = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
+	minSigPopDST = []byte("BLS_POP_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
+)
+
+// MinSigPublicKey used in the minimal-signature-size BLS signature scheme,
+// where public keys are points in G2.
+type MinSigPublicKey struct {
+	p *blstMinSigPublicKey
+}
+
+// Marshal a public key into a compressed byte slice.
+func (p *MinSigPublicKey) Marshal() []byte {
+	return p.p.Compress()
+}
+
+// Copy the public key to a new pointer reference.
+func (p *MinSigPublicKey) Copy() PubKey {
+	np := *p.p
+	return &MinSigPublicKey{p: &np}
+}
+
+// Equals checks if the provided public key is equal to
+// the current one.
+func (p *MinSigPublicKey) Equals(p2 PubKey) bool {
+	other := rawMinSigPublicKey(p2)
+	return other != nil && p.p.Equals(other)
+}
+
+// rawMinSigPublicKey returns the blst public key behind pubKey, or nil if
+// pubKey is not a minimal-signature-size public key.
+func rawMinSigPublicKey(pubKey PubKey) *blstMinSigPublicKey {
+	p, ok := pubKey.(*MinSigPublicKey)
+	if !ok || p == nil {
+		return nil
+	}
+	return p.p
+}
+
+// minSigPublicKeyFromBytes creates a G2 public key from a compressed byte
+// slice. Public keys of this variant are not cached.
+func minSigPublicKeyFromBytes(pubKey []byte) (*MinSigPublicKey, error) {
+	if len(pubKey) != MinSigPubkeyLength {
+		return nil, fmt.Errorf("public key must be %d bytes", MinSigPubkeyLength)
+	}
+	// Subgroup check NOT done when decompressing pubkey.
+	p := new(blstMinSigPublicKey).Uncompress(pubKey)
+	if p == nil {
+		return nil, errors.New("could not unmarshal bytes into public key")
+	}
+	// Subgroup and infinity check
+	if !p.KeyValidate() {
+		return nil, errors.New("publickey is infinite")
+	}
+	return &MinSigPublicKey{p: p}, nil
+}
+
+// MinSigSignature used in the minimal-signature-size BLS signature scheme,
+// where signatures are points in G1.
+type MinSigSignature struct {
+	s *blstMinSigSignature
+}
+
+// Marshal a signature into a compressed byte slice.
+func (s *MinSigSignature) Marshal() []byte {
+	return s.s.Compress()
+}
+
+// Copy returns a full deep copy of a signature.
+func (s *MinSigSignature) Copy() SignatureI {
+	sign := *s.s
+	return &MinSigSignature{s: &sign}
+}
+
+// Verify verifies the signature of msg. It returns false if pubKey is not a
+// minimal-signature-size public key.
+func (s *MinSigSignature) Verify(pubKey PubKey, msg []byte) bool {
+	p := rawMinSigPublicKey(pubKey)
+	if p == nil {
+		return false
+	}
+	// Signature and PKs are assumed to have been validated upon decompression!
+	return s.s.Verify(false, p, false, msg, minSigDST)
+}
+
+// VerifyWithDST verifies the signature of msg under an application-supplied
+// domain separation tag. An invalid tag never verifies.
+func (s *MinSigSignature) VerifyWithDST(pubKey PubKey, msg []byte, tag []byte) bool {
+	p := rawMinSigPublicKey(pubKey)
+	if p == nil || validateDST(tag) != nil {
+		return false
+	}
+	// Signature and PKs are assumed to have been validated upon decompression!
+	return s.s.Verify(false, p, false, msg, tag)
+}
+
+// AggregateVerify verifies each public key against its respective message. This is vulnerable to
+// rogue public-key attack. Each user must provide a proof-of-knowledge of the public key.
+func (s *MinSigSignature) AggregateVerify(pubKeys []PubKey, msgs [][32]byte) bool {
+	size := len(pubKeys)
+	if size == 0 {
+		return false
+	}
+	if size != len(msgs) {
+		return false
+	}
+	msgSlices := make([][]byte, len(msgs))
+	rawKeys := make([]*blstMinSigPublicKey, len(msgs))
+	for i := 0; i < size; i++ {
+		msgSlices[i] = msgs[i][:]
+		if rawKeys[i] = rawMinSigPublicKey(pubKeys[i]); rawKeys[i] == nil {
+			return false
+		}
+	}
+	// Signature and PKs are assumed to have been validated upon decompression!
+	return s.s.AggregateVerify(false, rawKeys, false, msgSlices, minSigDST)
+}
+
+// FastAggregateVerify verifies all the provided public keys with their aggregated signature
+// over the same message. This is vulnerable to rogue public-key attack. Each user must
+// provide a proof-of-knowledge of the public key.
+func (s *MinSigSignature) FastAggregateVerify(pubKeys []PubKey, msg [32]byte) bool {
+	if len(pubKeys) == 0 {
+		return false
+	}
+	rawKeys := make([]*blstMinSigPublicKey, len(pubKeys))
+	for i := 0; i < len(pubKeys); i++ {
+		if rawKeys[i] = rawMinSigPublicKey(pubKeys[i]); rawKeys[i] == nil {
+			return false
+		}
+	}
+	// Signature and PKs are assumed to have been validated upon decompression!
+	return s.s.FastAggregateVerify(false, rawKeys, msg[:], minSigDST)
+}
+
+// rawMinSigSignature returns the blst signature behind sig, or nil if sig is
+// not a minimal-signature-size signature.
+func rawMinSigSignature(sig SignatureI) *blstMinSigSignature {
+	s, ok := sig.(*MinSigSignature)
+	if !ok || s == nil {
+		return nil
+	}
+	return s.s
+}
+
+// minSigSignatureFromBytes creates a G1 signature from a compressed byte
+// slice, group checking it.
+func minSigSignatureFromBytes(sig []byte) (*MinSigSignature, error) {
+	if len(sig) != MinSigSignatureLength {
+		return nil, fmt.Errorf("could not create signature from byte slice: signature must be %d bytes", MinSigSignatureLength)
+	}
+	signature := new(blstMinSigSignature).Uncompress(sig)
+	if signature == nil {
+		return nil, errors.New("could not create signature from byte slice: could not unmarshal bytes into signature")
+	}
+	// Group check signature. Do not check for infinity since an aggregated signature
+	// could be infinite.
+	if !signature.SigValidate(false) {
+		return nil, errors.New("could not create signature from byte slice: signature not in group")
+	}
+	return &MinSigSignature{s: signature}, nil
+}
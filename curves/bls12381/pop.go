@@ -0,0 +1,36 @@
+package blst
+
+import "fmt"
+
+// popDST is the domain separation tag used for proofs of possession, as
+// defined by the proof-of-possession ciphersuite of the IETF BLS draft.
+// It must differ from dst so that a proof can never be replayed as a
+// signature over a message and vice versa.
+var popDST = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// ProvePossession produces a proof of possession of the secret key, which is a
+// signature over the compressed public key under the POP domain tag. It
+// panics with ErrSecretKeyZeroized if the key has been zeroized.
+func (s *bls12SecretKey) ProvePossession() SignatureI {
+	sk := s.mustKey()
+	pubKey := new(blstPublicKey).From(sk).Compress()
+	proof := new(blstSignature).Sign(sk, pubKey, popDST)
+	return &Signature{s: proof}
+}
+
+// PopVerify verifies a compressed proof of possession against a public key.
+// A valid proof must be checked for every public key before it is used in
+// FastAggregateVerify or AggregatePublicKeys, to prevent rogue-key attacks.
+func PopVerify(pubKey PubKey, proof []byte) (bool, error) {
+	p := rawPublicKey(pubKey)
+	if p == nil {
+		return false, fmt.Errorf("unsupported public key type %T", pubKey)
+	}
+	rProof, err := SignatureFromBytes(proof)
+	if err != nil {
+		return false, err
+	}
+	// The proof was group checked upon decompression, but the public key is
+	// validated again since an aggregated key must never be registered.
+	return rProof.(*Signature).s.Verify(false, p, true, p.Compress(), popDST), nil
+}
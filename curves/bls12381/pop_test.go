@@ -0,0 +1,49 @@
+package blst
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvePossession(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey()
+
+	proof := priv.ProvePossession().Marshal()
+	valid, err := PopVerify(pub, proof)
+	require.NoError(t, err)
+	assert.True(t, valid, "Proof of possession did not verify")
+
+	other, err := RandKey()
+	require.NoError(t, err)
+	valid, err = PopVerify(other.PublicKey(), proof)
+	require.NoError(t, err)
+	assert.False(t, valid, "Proof of possession verified for another key")
+}
+
+func TestProvePossession_NotASignature(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey()
+
+	// A regular signature over the public key must not be accepted as a proof,
+	// and a proof must not be accepted as a regular signature.
+	sig := priv.Sign(pub.Marshal())
+	valid, err := PopVerify(pub, sig.Marshal())
+	require.NoError(t, err)
+	assert.False(t, valid, "Signature accepted as proof of possession")
+
+	proof := priv.ProvePossession()
+	assert.False(t, proof.Verify(pub, pub.Marshal()), "Proof of possession accepted as signature")
+}
+
+func TestPopVerify_InvalidProof(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+
+	_, err = PopVerify(priv.PublicKey(), make([]byte, SignatureLength-1))
+	assert.ErrorContains(t, err, "signature must be 96 bytes")
+}
@@ -34,7 +34,34 @@ func (p *PublicKey) Copy() PubKey {
 // Equals checks if the provided public key is equal to
 // the current one.
 func (p *PublicKey) Equals(p2 PubKey) bool {
-	return p.p.Equals(p2.(*PublicKey).p)
+	other := rawPublicKey(p2)
+	return other != nil && p.p.Equals(other)
+}
+
+// Aggregate two public keys. It returns nil, leaving p unchanged, if p2 is not
+// a minimal-pubkey-size public key.
+func (p *PublicKey) Aggregate(p2 PubKey) PubKey {
+	other := rawPublicKey(p2)
+	if other == nil {
+		return nil
+	}
+	agg := new(blstAggregatePublicKey)
+	// No group check here since it is checked at decompression time
+	agg.Add(p.p, false)
+	agg.Add(other, false)
+	p.p = agg.ToAffine()
+
+	return p
+}
+
+// rawPublicKey returns the blst public key behind pubKey, or nil if pubKey is
+// not a minimal-pubkey-size public key.
+func rawPublicKey(pubKey PubKey) *blstPublicKey {
+	p, ok := pubKey.(*PublicKey)
+	if !ok || p == nil {
+		return nil
+	}
+	return p.p
 }
 
 // PublicKeyFromBytes creates a BLS public key from a  BigEndian byte slice.
@@ -48,11 +75,16 @@ func publicKeyFromBytes(pubKey []byte, cacheCopy bool) (PubKey, error) {
 	}
 
 	newKey := (*[PubkeyLength]byte)(pubKey)
-	if cv, ok := pubkeyCache.Get(*newKey); ok {
-		if cacheCopy {
-			return cv.Copy(), nil
+	useCache := cacheEnabled.Load()
+	if useCache {
+		if cv, ok := pubkeyCache.Get(*newKey); ok {
+			cacheHits.Add(1)
+			if cacheCopy {
+				return cv.Copy(), nil
+			}
+			return cv, nil
 		}
-		return cv, nil
+		cacheMisses.Add(1)
 	}
 
 	// Subgroup check NOT done when decompressing pubkey.
@@ -67,8 +99,50 @@ func publicKeyFromBytes(pubKey []byte, cacheCopy bool) (PubKey, error) {
 	}
 
 	pubKeyObj := &PublicKey{p: p}
-	copiedKey := pubKeyObj.Copy()
-	cacheKey := *newKey
-	pubkeyCache.Add(cacheKey, copiedKey)
+	if useCache {
+		copiedKey := pubKeyObj.Copy()
+		cacheKey := *newKey
+		pubkeyCache.Add(cacheKey, copiedKey)
+	}
 	return pubKeyObj, nil
 }
+
+// AggregatePublicKeys aggregates the provided raw public keys into a single key.
+func AggregatePublicKeys(pubs [][]byte) (PubKey, error) {
+	if len(pubs) == 0 {
+		return nil, errors.New("provided public keys are empty")
+	}
+	agg := new(blstAggregatePublicKey)
+	mulP1 := make([]*blstPublicKey, 0, len(pubs))
+	for _, pubkey := range pubs {
+		pubKeyObj, err := publicKeyFromBytes(pubkey, false)
+		if err != nil {
+			return nil, err
+		}
+		mulP1 = append(mulP1, pubKeyObj.(*PublicKey).p)
+	}
+	// No group check needed here since it is done in PublicKeyFromBytes
+	agg.Aggregate(mulP1, false)
+	return &PublicKey{p: agg.ToAffine()}, nil
+}
+
+// AggregateMultiplePubkeys aggregates the provided decompressed keys into a
+// single key. It returns nil if the list is empty or holds a public key of
+// another variant.
+func AggregateMultiplePubkeys(pubkeys []PubKey) PubKey {
+	if len(pubkeys) == 0 {
+		return nil
+	}
+	mulP1 := make([]*blstPublicKey, 0, len(pubkeys))
+	for _, pubkey := range pubkeys {
+		p := rawPublicKey(pubkey)
+		if p == nil {
+			return nil
+		}
+		mulP1 = append(mulP1, p)
+	}
+	agg := new(blstAggregatePublicKey)
+	// No group check needed here since it is done in PublicKeyFromBytes
+	agg.Aggregate(mulP1, false)
+	return &PublicKey{p: agg.ToAffine()}
+}
@@ -85,7 +85,8 @@ func BenchmarkPublicKeyFromBytes(b *testing.B) {
 	})
 
 	b.Run("cache off", func(b *testing.B) {
-		// blst.DisableCaches()
+		blst.DisableCaches()
+		defer blst.EnableCaches()
 		for i := 0; i < b.N; i++ {
 			_, err := blst.PublicKeyFromBytes(pubkeyBytes)
 			require.NoError(b, err)
@@ -93,3 +94,37 @@ func BenchmarkPublicKeyFromBytes(b *testing.B) {
 	})
 
 }
+
+func TestAggregatePublicKeys(t *testing.T) {
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	pubkeys := make([]blst.PubKey, 0, 10)
+	rawKeys := make([][]byte, 0, 10)
+	sigs := make([]blst.SignatureI, 0, 10)
+	for i := 0; i < 10; i++ {
+		priv, err := blst.RandKey()
+		require.NoError(t, err)
+		pubkeys = append(pubkeys, priv.PublicKey())
+		rawKeys = append(rawKeys, priv.PublicKey().Marshal())
+		sigs = append(sigs, priv.Sign(msg[:]))
+	}
+
+	aggKey, err := blst.AggregatePublicKeys(rawKeys)
+	require.NoError(t, err)
+	assert.True(t, aggKey.Equals(blst.AggregateMultiplePubkeys(pubkeys)))
+
+	// The aggregate key verifies the aggregate signature over the common message.
+	aggSig := blst.AggregateSignatures(sigs)
+	assert.True(t, aggSig.Verify(aggKey, msg[:]))
+
+	pairwise := pubkeys[0].Copy().(*blst.PublicKey)
+	for _, pub := range pubkeys[1:] {
+		pairwise.Aggregate(pub)
+	}
+	assert.True(t, aggKey.Equals(pairwise))
+
+	_, err = blst.AggregatePublicKeys(nil)
+	assert.ErrorContains(t, err, "provided public keys are empty")
+	_, err = blst.AggregatePublicKeys([][]byte{rawKeys[0][1:]})
+	assert.ErrorContains(t, err, "public key must be 48 bytes")
+	assert.Nil(t, blst.AggregateMultiplePubkeys(nil))
+}
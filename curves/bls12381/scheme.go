@@ -0,0 +1,252 @@
+package blst
+
+import (
+	"errors"
+	"fmt"
+
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// Variant selects which group holds public keys and which holds signatures.
+type Variant int
+
+const (
+	// MinPubKeySize is the variant with 48-byte public keys in G1 and 96-byte
+	// signatures in G2. It is the variant used by the package-level functions.
+	MinPubKeySize Variant = iota
+	// MinSigSize is the variant with 96-byte public keys in G2 and 48-byte
+	// signatures in G1.
+	MinSigSize
+)
+
+func (v Variant) String() string {
+	switch v {
+	case MinPubKeySize:
+		return "minimal-pubkey-size"
+	case MinSigSize:
+		return "minimal-signature-size"
+	default:
+		return fmt.Sprintf("Variant(%d)", int(v))
+	}
+}
+
+// Scheme groups the operations of one BLS variant. Secret keys are shared by
+// both variants; public keys and signatures of different variants must not be
+// mixed, and are rejected by the verification and aggregation functions.
+//
+// Scheme covers signing, verification, proofs of possession, aggregation and
+// batch verification. Threshold signatures, VRFs, key derivation and the
+// keystore only exist for the minimal-pubkey-size variant, through the
+// package-level functions.
+//
+// Like the SecretKey methods, PublicKey, Sign and ProvePossession panic with
+// ErrSecretKeyZeroized if the secret key has been zeroized.
+type Scheme interface {
+	Variant() Variant
+	PubkeyLength() int
+	SignatureLength() int
+
+	PublicKey(secKey SecretKey) PubKey
+	Sign(secKey SecretKey, msg []byte) SignatureI
+	SignWithDST(secKey SecretKey, msg []byte, dst []byte) (SignatureI, error)
+	ProvePossession(secKey SecretKey) SignatureI
+
+	PublicKeyFromBytes(pubKey []byte) (PubKey, error)
+	SignatureFromBytes(sig []byte) (SignatureI, error)
+
+	VerifySignature(sig []byte, msg [32]byte, pubKey PubKey) (bool, error)
+	VerifyMultipleSignatures(sigs [][]byte, msgs [][32]byte, pubKeys []PubKey) (bool, error)
+	PopVerify(pubKey PubKey, proof []byte) (bool, error)
+	AggregateSignatures(sigs []SignatureI) SignatureI
+	AggregatePublicKeys(pubs [][]byte) (PubKey, error)
+}
+
+// NewScheme returns the Scheme implementing the given variant.
+func NewScheme(variant Variant) (Scheme, error) {
+	switch variant {
+	case MinPubKeySize:
+		return minPubKeyScheme{}, nil
+	case MinSigSize:
+		return minSigScheme{}, nil
+	default:
+		return nil, fmt.Errorf("unknown BLS variant %v", variant)
+	}
+}
+
+// minPubKeyScheme delegates to the package-level functions.
+type minPubKeyScheme struct{}
+
+func (minPubKeyScheme) Variant() Variant     { return MinPubKeySize }
+func (minPubKeyScheme) PubkeyLength() int    { return PubkeyLength }
+func (minPubKeyScheme) SignatureLength() int { return SignatureLength }
+
+func (minPubKeyScheme) PublicKey(secKey SecretKey) PubKey {
+	return secKey.PublicKey()
+}
+
+func (minPubKeyScheme) Sign(secKey SecretKey, msg []byte) SignatureI {
+	return secKey.Sign(msg)
+}
+
+func (minPubKeyScheme) SignWithDST(secKey SecretKey, msg []byte, tag []byte) (SignatureI, error) {
+	return secKey.SignWithDST(msg, tag)
+}
+
+func (minPubKeyScheme) ProvePossession(secKey SecretKey) SignatureI {
+	return secKey.ProvePossession()
+}
+
+func (minPubKeyScheme) PublicKeyFromBytes(pubKey []byte) (PubKey, error) {
+	return PublicKeyFromBytes(pubKey)
+}
+
+func (minPubKeyScheme) SignatureFromBytes(sig []byte) (SignatureI, error) {
+	return SignatureFromBytes(sig)
+}
+
+func (minPubKeyScheme) VerifySignature(sig []byte, msg [32]byte, pubKey PubKey) (bool, error) {
+	return VerifySignature(sig, msg, pubKey)
+}
+
+func (minPubKeyScheme) VerifyMultipleSignatures(sigs [][]byte, msgs [][32]byte, pubKeys []PubKey) (bool, error) {
+	return VerifyMultipleSignatures(sigs, msgs, pubKeys)
+}
+
+func (minPubKeyScheme) PopVerify(pubKey PubKey, proof []byte) (bool, error) {
+	return PopVerify(pubKey, proof)
+}
+
+func (minPubKeyScheme) AggregateSignatures(sigs []SignatureI) SignatureI {
+	return AggregateSignatures(sigs)
+}
+
+func (minPubKeyScheme) AggregatePublicKeys(pubs [][]byte) (PubKey, error) {
+	return AggregatePublicKeys(pubs)
+}
+
+// minSigScheme implements the minimal-signature-size variant.
+type minSigScheme struct{}
+
+func (minSigScheme) Variant() Variant     { return MinSigSize }
+func (minSigScheme) PubkeyLength() int    { return MinSigPubkeyLength }
+func (minSigScheme) SignatureLength() int { return MinSigSignatureLength }
+
+func (minSigScheme) PublicKey(secKey SecretKey) PubKey {
+	sk := mustRawSecretKey(secKey)
+	return &MinSigPublicKey{p: new(blstMinSigPublicKey).From(sk)}
+}
+
+func (minSigScheme) Sign(secKey SecretKey, msg []byte) SignatureI {
+	sk := mustRawSecretKey(secKey)
+	signature := new(blstMinSigSignature).Sign(sk, msg, minSigDST)
+	return &MinSigSignature{s: signature}
+}
+
+func (minSigScheme) SignWithDST(secKey SecretKey, msg []byte, tag []byte) (SignatureI, error) {
+	sk, err := rawSecretKey(secKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDST(tag); err != nil {
+		return nil, err
+	}
+	signature := new(blstMinSigSignature).Sign(sk, msg, tag)
+	return &MinSigSignature{s: signature}, nil
+}
+
+func (minSigScheme) ProvePossession(secKey SecretKey) SignatureI {
+	sk := mustRawSecretKey(secKey)
+	pubKey := new(blstMinSigPublicKey).From(sk).Compress()
+	return &MinSigSignature{s: new(blstMinSigSignature).Sign(sk, pubKey, minSigPopDST)}
+}
+
+func (minSigScheme) PublicKeyFromBytes(pubKey []byte) (PubKey, error) {
+	return minSigPublicKeyFromBytes(pubKey)
+}
+
+func (minSigScheme) SignatureFromBytes(sig []byte) (SignatureI, error) {
+	return minSigSignatureFromBytes(sig)
+}
+
+func (minSigScheme) VerifySignature(sig []byte, msg [32]byte, pubKey PubKey) (bool, error) {
+	rSig, err := minSigSignatureFromBytes(sig)
+	if err != nil {
+		return false, err
+	}
+	return rSig.Verify(pubKey, msg[:]), nil
+}
+
+func (minSigScheme) VerifyMultipleSignatures(sigs [][]byte, msgs [][32]byte, pubKeys []PubKey) (bool, error) {
+	if len(sigs) == 0 || len(pubKeys) == 0 {
+		return false, nil
+	}
+	length := len(sigs)
+	if length != len(pubKeys) || length != len(msgs) {
+		return false, fmt.Errorf("provided signatures, pubkeys and messages have differing lengths. S: %d, P: %d,M %d",
+			length, len(pubKeys), len(msgs))
+	}
+	rawSigs := new(blstMinSigSignature).BatchUncompress(sigs)
+	if rawSigs == nil {
+		return false, errors.New("could not unmarshal bytes into signatures")
+	}
+	rawKeys := make([]*blstMinSigPublicKey, length)
+	rawMsgs := make([]blst.Message, length)
+	for i := 0; i < length; i++ {
+		if rawKeys[i] = rawMinSigPublicKey(pubKeys[i]); rawKeys[i] == nil {
+			return false, fmt.Errorf("unsupported public key type %T", pubKeys[i])
+		}
+		rawMsgs[i] = msgs[i][:]
+	}
+	dummySig := new(blstMinSigSignature)
+
+	// Validate signatures since we uncompress them here. Public keys should already be validated.
+	return dummySig.MultipleAggregateVerify(rawSigs, true, rawKeys, false, rawMsgs, minSigDST, randScalarFunc(), randBitsEntropy), nil
+}
+
+func (minSigScheme) PopVerify(pubKey PubKey, proof []byte) (bool, error) {
+	rProof, err := minSigSignatureFromBytes(proof)
+	if err != nil {
+		return false, err
+	}
+	p := rawMinSigPublicKey(pubKey)
+	if p == nil {
+		return false, fmt.Errorf("unsupported public key type %T", pubKey)
+	}
+	return rProof.s.Verify(false, p, true, p.Compress(), minSigPopDST), nil
+}
+
+func (minSigScheme) AggregateSignatures(sigs []SignatureI) SignatureI {
+	if len(sigs) == 0 {
+		return nil
+	}
+	rawSigs := make([]*blstMinSigSignature, len(sigs))
+	for i := 0; i < len(sigs); i++ {
+		if rawSigs[i] = rawMinSigSignature(sigs[i]); rawSigs[i] == nil {
+			return nil
+		}
+	}
+	// Signature and PKs are assumed to have been validated upon decompression!
+	signature := new(blstMinSigAggregateSignature)
+	if !signature.Aggregate(rawSigs, false) {
+		return nil
+	}
+	return &MinSigSignature{s: signature.ToAffine()}
+}
+
+func (minSigScheme) AggregatePublicKeys(pubs [][]byte) (PubKey, error) {
+	if len(pubs) == 0 {
+		return nil, errors.New("provided public keys are empty")
+	}
+	rawKeys := make([]*blstMinSigPublicKey, 0, len(pubs))
+	for _, pubkey := range pubs {
+		pubKeyObj, err := minSigPublicKeyFromBytes(pubkey)
+		if err != nil {
+			return nil, err
+		}
+		rawKeys = append(rawKeys, pubKeyObj.p)
+	}
+	agg := new(blstMinSigAggregatePublicKey)
+	// No group check needed here since it is done in minSigPublicKeyFromBytes
+	agg.Aggregate(rawKeys, false)
+	return &MinSigPublicKey{p: agg.ToAffine()}, nil
+}
@@ -0,0 +1,204 @@
+package blst_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	blst "github.com/cosmos/crypto/curves/bls12381"
+)
+
+func TestScheme_Lengths(t *testing.T) {
+	tests := []struct {
+		variant   blst.Variant
+		pubkeyLen int
+		sigLen    int
+	}{
+		{variant: blst.MinPubKeySize, pubkeyLen: 48, sigLen: 96},
+		{variant: blst.MinSigSize, pubkeyLen: 96, sigLen: 48},
+	}
+	for _, test := range tests {
+		t.Run(test.variant.String(), func(t *testing.T) {
+			scheme, err := blst.NewScheme(test.variant)
+			require.NoError(t, err)
+			assert.Equal(t, test.variant, scheme.Variant())
+			assert.Equal(t, test.pubkeyLen, scheme.PubkeyLength())
+			assert.Equal(t, test.sigLen, scheme.SignatureLength())
+
+			priv, err := blst.RandKey()
+			require.NoError(t, err)
+			assert.Len(t, scheme.PublicKey(priv).Marshal(), test.pubkeyLen)
+			assert.Len(t, scheme.Sign(priv, []byte("hello")).Marshal(), test.sigLen)
+		})
+	}
+
+	_, err := blst.NewScheme(blst.Variant(42))
+	assert.ErrorContains(t, err, "unknown BLS variant Variant(42)")
+}
+
+func TestScheme_SignVerify(t *testing.T) {
+	for _, variant := range []blst.Variant{blst.MinPubKeySize, blst.MinSigSize} {
+		t.Run(variant.String(), func(t *testing.T) {
+			scheme, err := blst.NewScheme(variant)
+			require.NoError(t, err)
+			priv, err := blst.RandKey()
+			require.NoError(t, err)
+
+			pub, err := scheme.PublicKeyFromBytes(scheme.PublicKey(priv).Marshal())
+			require.NoError(t, err)
+			assert.True(t, pub.Equals(scheme.PublicKey(priv)))
+
+			msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+			sig := scheme.Sign(priv, msg[:]).Marshal()
+			valid, err := scheme.VerifySignature(sig, msg, pub)
+			require.NoError(t, err)
+			assert.True(t, valid, "Signature did not verify")
+
+			otherMsg := [32]byte{'o', 'l', 'l', 'e', 'h'}
+			valid, err = scheme.VerifySignature(sig, otherMsg, pub)
+			require.NoError(t, err)
+			assert.False(t, valid, "Signature did verify")
+
+			_, err = scheme.VerifySignature(sig[1:], msg, pub)
+			assert.Error(t, err)
+			_, err = scheme.PublicKeyFromBytes(pub.Marshal()[1:])
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestScheme_Aggregate(t *testing.T) {
+	for _, variant := range []blst.Variant{blst.MinPubKeySize, blst.MinSigSize} {
+		t.Run(variant.String(), func(t *testing.T) {
+			scheme, err := blst.NewScheme(variant)
+			require.NoError(t, err)
+
+			msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+			pubkeys := make([]blst.PubKey, 0, 10)
+			rawKeys := make([][]byte, 0, 10)
+			sigs := make([]blst.SignatureI, 0, 10)
+			msgs := make([][32]byte, 0, 10)
+			distinctSigs := make([]blst.SignatureI, 0, 10)
+			for i := 0; i < 10; i++ {
+				priv, err := blst.RandKey()
+				require.NoError(t, err)
+				pub := scheme.PublicKey(priv)
+
+				valid, err := scheme.PopVerify(pub, scheme.ProvePossession(priv).Marshal())
+				require.NoError(t, err)
+				require.True(t, valid, "Proof of possession did not verify")
+
+				pubkeys = append(pubkeys, pub)
+				rawKeys = append(rawKeys, pub.Marshal())
+				sigs = append(sigs, scheme.Sign(priv, msg[:]))
+
+				distinctMsg := [32]byte{'h', 'e', 'l', 'l', 'o', byte(i)}
+				msgs = append(msgs, distinctMsg)
+				distinctSigs = append(distinctSigs, scheme.Sign(priv, distinctMsg[:]))
+			}
+
+			aggSig := scheme.AggregateSignatures(sigs)
+			require.NotNil(t, aggSig)
+			assert.Len(t, aggSig.Marshal(), scheme.SignatureLength())
+			assert.True(t, aggSig.FastAggregateVerify(pubkeys, msg), "Signature did not verify")
+
+			aggKey, err := scheme.AggregatePublicKeys(rawKeys)
+			require.NoError(t, err)
+			assert.True(t, aggSig.Verify(aggKey, msg[:]), "Signature did not verify")
+
+			aggDistinct := scheme.AggregateSignatures(distinctSigs)
+			require.NotNil(t, aggDistinct)
+			assert.True(t, aggDistinct.AggregateVerify(pubkeys, msgs), "Signature did not verify")
+			assert.False(t, aggDistinct.FastAggregateVerify(pubkeys, msg), "Signature did verify")
+		})
+	}
+}
+
+func TestScheme_MinPubKeyRejectsMinSigValues(t *testing.T) {
+	minSig, err := blst.NewScheme(blst.MinSigSize)
+	require.NoError(t, err)
+	sk, err := blst.RandKey()
+	require.NoError(t, err)
+	msg := [32]byte{'m', 's', 'g'}
+
+	pub := sk.PublicKey()
+	sig := sk.Sign(msg[:])
+	otherPub := minSig.PublicKey(sk)
+	otherSig := minSig.Sign(sk, msg[:])
+
+	assert.False(t, sig.Verify(otherPub, msg[:]))
+	assert.False(t, sig.VerifyWithDST(otherPub, msg[:], []byte("OTHER_DST")))
+	assert.False(t, sig.AggregateVerify([]blst.PubKey{otherPub}, [][32]byte{msg}))
+	assert.False(t, sig.FastAggregateVerify([]blst.PubKey{pub, otherPub}, msg))
+	assert.False(t, pub.Equals(otherPub))
+	assert.Nil(t, blst.AggregateSignatures([]blst.SignatureI{sig, otherSig}))
+	assert.Nil(t, blst.AggregateMultiplePubkeys([]blst.PubKey{pub, otherPub}))
+	assert.Nil(t, pub.(*blst.PublicKey).Aggregate(otherPub))
+
+	_, err = blst.VerifyMultipleSignatures([][]byte{sig.Marshal()}, [][32]byte{msg}, []blst.PubKey{otherPub})
+	assert.Error(t, err)
+	_, err = blst.PopVerify(otherPub, sk.ProvePossession().Marshal())
+	assert.Error(t, err)
+}
+
+func TestScheme_MinSigRejectsMinPubKeyValues(t *testing.T) {
+	minSig, err := blst.NewScheme(blst.MinSigSize)
+	require.NoError(t, err)
+	sk, err := blst.RandKey()
+	require.NoError(t, err)
+	msg := [32]byte{'m', 's', 'g'}
+
+	pub := minSig.PublicKey(sk)
+	sig := minSig.Sign(sk, msg[:])
+	otherPub := sk.PublicKey()
+	otherSig := sk.Sign(msg[:])
+
+	assert.False(t, sig.Verify(otherPub, msg[:]))
+	assert.False(t, sig.VerifyWithDST(otherPub, msg[:], []byte("OTHER_DST")))
+	assert.False(t, sig.AggregateVerify([]blst.PubKey{otherPub}, [][32]byte{msg}))
+	assert.False(t, sig.FastAggregateVerify([]blst.PubKey{pub, otherPub}, msg))
+	assert.False(t, pub.Equals(otherPub))
+	assert.Nil(t, minSig.AggregateSignatures([]blst.SignatureI{sig, otherSig}))
+
+	_, err = minSig.VerifyMultipleSignatures([][]byte{sig.Marshal()}, [][32]byte{msg}, []blst.PubKey{otherPub})
+	assert.Error(t, err)
+	_, err = minSig.PopVerify(otherPub, minSig.ProvePossession(sk).Marshal())
+	assert.Error(t, err)
+}
+
+func TestScheme_VerifyMultipleSignatures(t *testing.T) {
+	for _, variant := range []blst.Variant{blst.MinPubKeySize, blst.MinSigSize} {
+		t.Run(variant.String(), func(t *testing.T) {
+			scheme, err := blst.NewScheme(variant)
+			require.NoError(t, err)
+
+			pubkeys := make([]blst.PubKey, 0, 10)
+			sigs := make([][]byte, 0, 10)
+			msgs := make([][32]byte, 0, 10)
+			for i := 0; i < 10; i++ {
+				priv, err := blst.RandKey()
+				require.NoError(t, err)
+				msg := [32]byte{'h', 'e', 'l', 'l', 'o', byte(i)}
+				pubkeys = append(pubkeys, scheme.PublicKey(priv))
+				sigs = append(sigs, scheme.Sign(priv, msg[:]).Marshal())
+				msgs = append(msgs, msg)
+			}
+
+			valid, err := scheme.VerifyMultipleSignatures(sigs, msgs, pubkeys)
+			require.NoError(t, err)
+			assert.True(t, valid, "Signatures did not verify")
+
+			msgs[0], msgs[1] = msgs[1], msgs[0]
+			valid, err = scheme.VerifyMultipleSignatures(sigs, msgs, pubkeys)
+			require.NoError(t, err)
+			assert.False(t, valid, "Signatures did verify")
+
+			_, err = scheme.VerifyMultipleSignatures(sigs, msgs[1:], pubkeys)
+			assert.Error(t, err)
+			sigs[0] = sigs[0][1:]
+			_, err = scheme.VerifyMultipleSignatures(sigs, msgs, pubkeys)
+			assert.Error(t, err)
+		})
+	}
+}
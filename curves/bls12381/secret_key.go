@@ -10,7 +10,11 @@ import (
 	"github.com/cosmos/crypto/internal/rand"
 )
 
-// bls12SecretKey used in the BLS signature scheme.
+// ErrSecretKeyZeroized is returned when a zeroized secret key is used.
+var ErrSecretKeyZeroized = errors.New("secret key has been zeroized")
+
+// bls12SecretKey used in the BLS signature scheme. p is nil once the key has
+// been zeroized.
 type bls12SecretKey struct {
 	p *blst.SecretKey
 }
@@ -19,6 +23,7 @@ type bls12SecretKey struct {
 func RandKey() (SecretKey, error) {
 	// Generate 32 bytes of randomness
 	var ikm [32]byte
+	defer clear(ikm[:])
 	_, err := rand.NewGenerator().Read(ikm[:])
 	if err != nil {
 		return nil, err
@@ -28,9 +33,10 @@ func RandKey() (SecretKey, error) {
 
 // GenPrivKeyFromSeed creates a new private key directly from the seed passed as parameter
 func GenPrivKeyFromSeed(seed [32]byte) (SecretKey, error) {
+	defer clear(seed[:])
 	// Defensive check, that we have not generated a secret key,
 	secKey := &bls12SecretKey{blst.KeyGen(seed[:])}
-	if IsZero(secKey.Marshal()) {
+	if secKey.isZero() {
 		return nil, errors.New("received secret key is zero")
 	}
 	return secKey, nil
@@ -61,18 +67,98 @@ func IsZero(sKey []byte) bool {
 	return subtle.ConstantTimeByteEq(b, 0) == 1
 }
 
+// Sign signs msg. It panics with ErrSecretKeyZeroized if the key has been
+// zeroized; SignWithDST returns the error instead.
 func (s *bls12SecretKey) Sign(msg []byte) SignatureI {
-	signature := new(blstSignature).Sign(s.p, msg, dst)
+	signature := new(blstSignature).Sign(s.mustKey(), msg, dst)
 	return &Signature{s: signature}
 }
 
-// Marshal a secret key into a LittleEndian byte slice.
+// SignWithDST signs msg under an application-supplied domain separation tag,
+// so that the signature cannot be replayed in another context.
+func (s *bls12SecretKey) SignWithDST(msg []byte, tag []byte) (SignatureI, error) {
+	if s.p == nil {
+		return nil, ErrSecretKeyZeroized
+	}
+	if err := validateDST(tag); err != nil {
+		return nil, err
+	}
+	signature := new(blstSignature).Sign(s.p, msg, tag)
+	return &Signature{s: signature}, nil
+}
+
+// Marshal a secret key into a LittleEndian byte slice. The returned slice is a
+// copy of the secret which the caller is responsible for wiping. It returns
+// nil if the key has been zeroized.
 func (s *bls12SecretKey) Marshal() []byte {
+	if s.p == nil {
+		return nil
+	}
 	keyBytes := s.p.Serialize()
 	return keyBytes
 }
 
-// PublicKey obtains the public key corresponding to the BLS secret key.
+// PublicKey obtains the public key corresponding to the BLS secret key. It
+// panics with ErrSecretKeyZeroized if the key has been zeroized.
 func (s *bls12SecretKey) PublicKey() PubKey {
-	return &PublicKey{p: new(blstPublicKey).From(s.p)}
+	return &PublicKey{p: new(blstPublicKey).From(s.mustKey())}
+}
+
+// Zeroize wipes the secret key from memory. The key must not be used
+// afterwards: Marshal returns nil, the functions returning an error return
+// ErrSecretKeyZeroized and the others panic with it. Zeroize is idempotent.
+func (s *bls12SecretKey) Zeroize() {
+	if s.p == nil {
+		return
+	}
+	s.p.Zeroize()
+	s.p = nil
+}
+
+// Destroy is an alias of Zeroize.
+func (s *bls12SecretKey) Destroy() {
+	s.Zeroize()
+}
+
+// IsZeroized reports whether Zeroize has been called on the key.
+func (s *bls12SecretKey) IsZeroized() bool {
+	return s.p == nil
+}
+
+// mustKey returns the blst secret key, panicking with ErrSecretKeyZeroized if
+// it has been wiped.
+func (s *bls12SecretKey) mustKey() *blst.SecretKey {
+	if s.p == nil {
+		panic(ErrSecretKeyZeroized)
+	}
+	return s.p
+}
+
+// rawSecretKey returns the blst secret key behind secKey, or
+// ErrSecretKeyZeroized if it has been wiped.
+func rawSecretKey(secKey SecretKey) (*blst.SecretKey, error) {
+	key, ok := secKey.(*bls12SecretKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported secret key type %T", secKey)
+	}
+	if key.p == nil {
+		return nil, ErrSecretKeyZeroized
+	}
+	return key.p, nil
+}
+
+// mustRawSecretKey is like rawSecretKey but panics on error.
+func mustRawSecretKey(secKey SecretKey) *blst.SecretKey {
+	sk, err := rawSecretKey(secKey)
+	if err != nil {
+		panic(err)
+	}
+	return sk
+}
+
+// isZero checks if the secret key is a zero key, wiping the serialized copy.
+func (s *bls12SecretKey) isZero() bool {
+	keyBytes := s.Marshal()
+	defer clear(keyBytes)
+	return IsZero(keyBytes)
 }
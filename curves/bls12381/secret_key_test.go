@@ -94,6 +94,74 @@ func TestZeroKey(t *testing.T) {
 	assert.Equal(t, false, blst.IsZero(zKey[:]))
 }
 
+func TestZeroize(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	assert.False(t, priv.IsZeroized())
+
+	priv.Zeroize()
+	assert.True(t, priv.IsZeroized())
+	assert.Nil(t, priv.Marshal())
+	assert.PanicsWithValue(t, blst.ErrSecretKeyZeroized, func() { priv.PublicKey() })
+	assert.PanicsWithValue(t, blst.ErrSecretKeyZeroized, func() { priv.Sign([]byte("hello")) })
+	assert.PanicsWithValue(t, blst.ErrSecretKeyZeroized, func() { priv.ProvePossession() })
+	_, err = priv.SignWithDST([]byte("hello"), blst.DefaultDST())
+	assert.ErrorIs(t, err, blst.ErrSecretKeyZeroized)
+
+	// Zeroize is idempotent.
+	priv.Zeroize()
+	assert.True(t, priv.IsZeroized())
+}
+
+func TestDestroy(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	priv.Destroy()
+	assert.True(t, priv.IsZeroized())
+	assert.Nil(t, priv.Marshal())
+	_, err = priv.SignWithDST([]byte("hello"), blst.DefaultDST())
+	assert.ErrorIs(t, err, blst.ErrSecretKeyZeroized)
+}
+
+func TestZeroize_Dependents(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	adapter := blst.NewPrivKeyAdapter(priv)
+	priv.Zeroize()
+
+	_, err = adapter.Sign([]byte("hello"))
+	assert.ErrorIs(t, err, blst.ErrSecretKeyZeroized)
+	assert.PanicsWithValue(t, blst.ErrSecretKeyZeroized, func() { adapter.PubKey() })
+	other, err := blst.RandKey()
+	require.NoError(t, err)
+	otherAdapter := blst.NewPrivKeyAdapter(other)
+	assert.False(t, adapter.Equals(otherAdapter))
+	assert.False(t, otherAdapter.Equals(adapter))
+	other.Zeroize()
+	// Two distinct zeroized keys must not compare equal.
+	assert.False(t, adapter.Equals(otherAdapter))
+	assert.False(t, otherAdapter.Equals(adapter))
+	assert.False(t, adapter.Equals(adapter))
+	minSig := blst.NewMinSigPrivKeyAdapter(priv)
+	assert.False(t, minSig.Equals(blst.NewMinSigPrivKeyAdapter(other)))
+	assert.False(t, minSig.Equals(minSig))
+
+	_, err = blst.DeriveChildSK(priv, 0)
+	assert.ErrorIs(t, err, blst.ErrSecretKeyZeroized)
+	_, err = blst.SplitSecretKey(priv, 2, 3)
+	assert.ErrorIs(t, err, blst.ErrSecretKeyZeroized)
+	_, err = blst.NewKeystore(priv, "password", "", blst.KDFPBKDF2)
+	assert.ErrorIs(t, err, blst.ErrSecretKeyZeroized)
+
+	scheme, err := blst.NewScheme(blst.MinSigSize)
+	require.NoError(t, err)
+	assert.PanicsWithValue(t, blst.ErrSecretKeyZeroized, func() { scheme.Sign(priv, []byte("hello")) })
+	assert.PanicsWithValue(t, blst.ErrSecretKeyZeroized, func() { scheme.PublicKey(priv) })
+	assert.PanicsWithValue(t, blst.ErrSecretKeyZeroized, func() { scheme.ProvePossession(priv) })
+	_, err = scheme.SignWithDST(priv, []byte("hello"), blst.DefaultDST())
+	assert.ErrorIs(t, err, blst.ErrSecretKeyZeroized)
+}
+
 // PadTo pads a byte slice to the given size. If the byte slice is larger than the given size, the
 // original slice is returned.
 func PadTo(b []byte, size int) []byte {
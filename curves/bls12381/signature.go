@@ -3,10 +3,20 @@ package blst
 import (
 	"errors"
 	"fmt"
+	"sync"
+
+	blst "github.com/supranational/blst/bindings/go"
+
+	"github.com/cosmos/crypto/internal/rand"
 )
 
 var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
 
+const (
+	scalarBytes     = 32
+	randBitsEntropy = 64
+)
+
 // Signature used in the BLS signature scheme.
 type Signature struct {
 	s *blstSignature
@@ -23,9 +33,104 @@ func (s *Signature) Copy() SignatureI {
 	return &Signature{s: &sign}
 }
 
+// Verify verifies the signature of msg. It returns false if pubKey is not a
+// minimal-pubkey-size public key.
 func (s *Signature) Verify(pubKey PubKey, msg []byte) bool {
+	p := rawPublicKey(pubKey)
+	if p == nil {
+		return false
+	}
+	// Signature and PKs are assumed to have been validated upon decompression!
+	return s.s.Verify(false, p, false, msg, dst)
+}
+
+// VerifyWithDST verifies the signature of msg under an application-supplied
+// domain separation tag. An invalid tag never verifies.
+func (s *Signature) VerifyWithDST(pubKey PubKey, msg []byte, tag []byte) bool {
+	p := rawPublicKey(pubKey)
+	if p == nil || validateDST(tag) != nil {
+		return false
+	}
+	// Signature and PKs are assumed to have been validated upon decompression!
+	return s.s.Verify(false, p, false, msg, tag)
+}
+
+// AggregateVerify verifies each public key against its respective message. This is vulnerable to
+// rogue public-key attack. Each user must provide a proof-of-knowledge of the public key.
+//
+// Note: The msgs must be distinct. For maximum performance, this method does not ensure distinct
+// messages.
+func (s *Signature) AggregateVerify(pubKeys []PubKey, msgs [][32]byte) bool {
+	size := len(pubKeys)
+	if size == 0 {
+		return false
+	}
+	if size != len(msgs) {
+		return false
+	}
+	msgSlices := make([][]byte, len(msgs))
+	rawKeys := make([]*blstPublicKey, len(msgs))
+	for i := 0; i < size; i++ {
+		msgSlices[i] = msgs[i][:]
+		if rawKeys[i] = rawPublicKey(pubKeys[i]); rawKeys[i] == nil {
+			return false
+		}
+	}
+	// Signature and PKs are assumed to have been validated upon decompression!
+	return s.s.AggregateVerify(false, rawKeys, false, msgSlices, dst)
+}
+
+// FastAggregateVerify verifies all the provided public keys with their aggregated signature
+// over the same message. This is vulnerable to rogue public-key attack. Each user must
+// provide a proof-of-knowledge of the public key.
+func (s *Signature) FastAggregateVerify(pubKeys []PubKey, msg [32]byte) bool {
+	if len(pubKeys) == 0 {
+		return false
+	}
+	rawKeys := make([]*blstPublicKey, len(pubKeys))
+	for i := 0; i < len(pubKeys); i++ {
+		if rawKeys[i] = rawPublicKey(pubKeys[i]); rawKeys[i] == nil {
+			return false
+		}
+	}
 	// Signature and PKs are assumed to have been validated upon decompression!
-	return s.s.Verify(false, pubKey.(*PublicKey).p, false, msg, dst)
+	return s.s.FastAggregateVerify(false, rawKeys, msg[:], dst)
+}
+
+// AggregateSignatures converts a list of signatures into a single, aggregated sig.
+// Returns nil if the list is empty, holds a signature of another variant or if
+// the signatures could not be aggregated.
+func AggregateSignatures(sigs []SignatureI) SignatureI {
+	if len(sigs) == 0 {
+		return nil
+	}
+
+	rawSigs := make([]*blstSignature, len(sigs))
+	for i := 0; i < len(sigs); i++ {
+		if rawSigs[i] = rawSignature(sigs[i]); rawSigs[i] == nil {
+			return nil
+		}
+	}
+
+	// Signature and PKs are assumed to have been validated upon decompression!
+	signature := new(blstAggregateSignature)
+	if !signature.Aggregate(rawSigs, false) {
+		return nil
+	}
+	return &Signature{s: signature.ToAffine()}
+}
+
+// AggregateCompressedSignatures converts a list of compressed signatures into a single,
+// aggregated sig. Every signature is group checked while being decompressed.
+func AggregateCompressedSignatures(multiSigs [][]byte) (SignatureI, error) {
+	if len(multiSigs) == 0 {
+		return nil, errors.New("provided signatures are empty")
+	}
+	signature := new(blstAggregateSignature)
+	if !signature.AggregateCompressed(multiSigs, true) {
+		return nil, errors.New("could not aggregate compressed signatures")
+	}
+	return &Signature{s: signature.ToAffine()}, nil
 }
 
 // VerifySignature verifies a single signature using public key and message.
@@ -37,6 +142,70 @@ func VerifySignature(sig []byte, msg [32]byte, pubKey PubKey) (bool, error) {
 	return rSig.Verify(pubKey, msg[:]), nil
 }
 
+// VerifyMultipleSignatures verifies a non-singular set of signatures and its respective pubkeys and messages.
+// This method provides a safe way to verify multiple signatures at once. We pick a number randomly from 1 to max
+// uint64 and then multiply the signature by it. We continue doing this for all signatures and its respective pubkeys.
+// S* = S_1 * r_1 + S_2 * r_2 + ... + S_n * r_n
+// P'_{i,j} = P_{i,j} * r_i
+// e(S*, G) = \prod_{i=1}^n \prod_{j=1}^{m_i} e(P'_{i,j}, M_{i,j})
+// Using this we can verify multiple signatures safely.
+func VerifyMultipleSignatures(sigs [][]byte, msgs [][32]byte, pubKeys []PubKey) (bool, error) {
+	if len(sigs) == 0 || len(pubKeys) == 0 {
+		return false, nil
+	}
+	length := len(sigs)
+	if length != len(pubKeys) || length != len(msgs) {
+		return false, fmt.Errorf("provided signatures, pubkeys and messages have differing lengths. S: %d, P: %d,M %d",
+			length, len(pubKeys), len(msgs))
+	}
+	rawSigs := new(blstSignature).BatchUncompress(sigs)
+	if rawSigs == nil {
+		return false, errors.New("could not unmarshal bytes into signatures")
+	}
+	mulP1Aff := make([]*blstPublicKey, length)
+	rawMsgs := make([]blst.Message, length)
+
+	for i := 0; i < length; i++ {
+		if mulP1Aff[i] = rawPublicKey(pubKeys[i]); mulP1Aff[i] == nil {
+			return false, fmt.Errorf("unsupported public key type %T", pubKeys[i])
+		}
+		rawMsgs[i] = msgs[i][:]
+	}
+	dummySig := new(blstSignature)
+
+	// Validate signatures since we uncompress them here. Public keys should already be validated.
+	return dummySig.MultipleAggregateVerify(rawSigs, true, mulP1Aff, false, rawMsgs, dst, randScalarFunc(), randBitsEntropy), nil
+}
+
+// randScalarFunc returns the function drawing the random non-zero scalars
+// used to batch verify signatures.
+func randScalarFunc() func(*blst.Scalar) {
+	// Secure source of RNG
+	randGen := rand.NewGenerator()
+	randLock := new(sync.Mutex)
+
+	return func(scalar *blst.Scalar) {
+		var rbytes [scalarBytes]byte
+		randLock.Lock()
+		randGen.Read(rbytes[:]) // #nosec G104 -- Error will always be nil in `read` in math/rand
+		randLock.Unlock()
+		// Protect against the generator returning 0. Since the scalar value is
+		// derived from a big endian byte slice, we take the last byte.
+		rbytes[len(rbytes)-1] |= 0x01
+		scalar.FromBEndian(rbytes[:])
+	}
+}
+
+// rawSignature returns the blst signature behind sig, or nil if sig is not a
+// minimal-pubkey-size signature.
+func rawSignature(sig SignatureI) *blstSignature {
+	s, ok := sig.(*Signature)
+	if !ok || s == nil {
+		return nil
+	}
+	return s.s
+}
+
 // signatureFromBytesNoValidation creates a BLS signature from a LittleEndian
 // byte slice. It does not validate that the signature is in the BLS group
 func signatureFromBytesNoValidation(sig []byte) (*blstSignature, error) {
@@ -180,3 +180,156 @@ func TestCopy(t *testing.T) {
 	signatureA.s.Sign(key.p, []byte("bar"), dst)
 	assert.NotEqual(t, signatureA, signatureB)
 }
+
+func TestAggregateVerify(t *testing.T) {
+	pubkeys := make([]PubKey, 0, 100)
+	sigs := make([]SignatureI, 0, 100)
+	var msgs [][32]byte
+	for i := 0; i < 100; i++ {
+		msg := [32]byte{'h', 'e', 'l', 'l', 'o', byte(i)}
+		priv, err := RandKey()
+		require.NoError(t, err)
+		pub := priv.PublicKey()
+		sig := priv.Sign(msg[:])
+		pubkeys = append(pubkeys, pub)
+		sigs = append(sigs, sig)
+		msgs = append(msgs, msg)
+	}
+	aggSig := AggregateSignatures(sigs)
+	require.NotNil(t, aggSig)
+	assert.True(t, aggSig.AggregateVerify(pubkeys, msgs), "Signature did not verify")
+
+	// Swapping two messages must invalidate the aggregate.
+	msgs[0], msgs[1] = msgs[1], msgs[0]
+	assert.False(t, aggSig.AggregateVerify(pubkeys, msgs), "Signature did verify")
+	assert.False(t, aggSig.AggregateVerify(pubkeys, msgs[:99]), "Mismatched lengths did verify")
+	assert.False(t, aggSig.AggregateVerify(nil, nil), "Empty keys did verify")
+}
+
+func TestFastAggregateVerify(t *testing.T) {
+	pubkeys := make([]PubKey, 0, 100)
+	sigs := make([]SignatureI, 0, 100)
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	for i := 0; i < 100; i++ {
+		priv, err := RandKey()
+		require.NoError(t, err)
+		pub := priv.PublicKey()
+		sig := priv.Sign(msg[:])
+		pubkeys = append(pubkeys, pub)
+		sigs = append(sigs, sig)
+	}
+	aggSig := AggregateSignatures(sigs)
+	require.NotNil(t, aggSig)
+	assert.True(t, aggSig.FastAggregateVerify(pubkeys, msg), "Signature did not verify")
+
+	otherMsg := [32]byte{'o', 'l', 'l', 'e', 'h'}
+	assert.False(t, aggSig.FastAggregateVerify(pubkeys, otherMsg), "Signature did verify")
+	assert.False(t, aggSig.FastAggregateVerify(pubkeys[1:], msg), "Signature did verify")
+	assert.False(t, aggSig.FastAggregateVerify(nil, msg), "Empty keys did verify")
+}
+
+func TestAggregateSignatures_Empty(t *testing.T) {
+	assert.Nil(t, AggregateSignatures(nil))
+
+	_, err := AggregateCompressedSignatures(nil)
+	assert.ErrorContains(t, err, "provided signatures are empty")
+}
+
+func TestAggregateCompressedSignatures(t *testing.T) {
+	pubkeys := make([]PubKey, 0, 10)
+	sigs := make([]SignatureI, 0, 10)
+	compressed := make([][]byte, 0, 10)
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	for i := 0; i < 10; i++ {
+		priv, err := RandKey()
+		require.NoError(t, err)
+		sig := priv.Sign(msg[:])
+		pubkeys = append(pubkeys, priv.PublicKey())
+		sigs = append(sigs, sig)
+		compressed = append(compressed, sig.Marshal())
+	}
+	aggSig, err := AggregateCompressedSignatures(compressed)
+	require.NoError(t, err)
+	assert.Equal(t, AggregateSignatures(sigs).Marshal(), aggSig.Marshal())
+	assert.True(t, aggSig.FastAggregateVerify(pubkeys, msg), "Signature did not verify")
+
+	compressed[0] = make([]byte, SignatureLength)
+	_, err = AggregateCompressedSignatures(compressed)
+	assert.ErrorContains(t, err, "could not aggregate compressed signatures")
+}
+
+func TestVerifyMultipleSignatures(t *testing.T) {
+	pubkeys := make([]PubKey, 0, 100)
+	sigs := make([][]byte, 0, 100)
+	var msgs [][32]byte
+	for i := 0; i < 100; i++ {
+		msg := [32]byte{'h', 'e', 'l', 'l', 'o', byte(i)}
+		priv, err := RandKey()
+		require.NoError(t, err)
+		pubkeys = append(pubkeys, priv.PublicKey())
+		sigs = append(sigs, priv.Sign(msg[:]).Marshal())
+		msgs = append(msgs, msg)
+	}
+	valid, err := VerifyMultipleSignatures(sigs, msgs, pubkeys)
+	require.NoError(t, err)
+	assert.True(t, valid, "Signatures did not verify")
+
+	// A single signature over the wrong message fails the whole batch.
+	msgs[42] = [32]byte{'o', 'l', 'l', 'e', 'h'}
+	valid, err = VerifyMultipleSignatures(sigs, msgs, pubkeys)
+	require.NoError(t, err)
+	assert.False(t, valid, "Signatures did verify")
+}
+
+func TestVerifyMultipleSignatures_InvalidInput(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	sig := priv.Sign(msg[:]).Marshal()
+	pub := priv.PublicKey()
+
+	valid, err := VerifyMultipleSignatures(nil, nil, nil)
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = VerifyMultipleSignatures([][]byte{sig, sig}, [][32]byte{msg}, []PubKey{pub})
+	assert.ErrorContains(t, err, "provided signatures, pubkeys and messages have differing lengths")
+
+	_, err = VerifyMultipleSignatures([][]byte{make([]byte, SignatureLength)}, [][32]byte{msg}, []PubKey{pub})
+	assert.ErrorContains(t, err, "could not unmarshal bytes into signatures")
+}
+
+func BenchmarkVerifyMultipleSignatures(b *testing.B) {
+	const count = 128
+	pubkeys := make([]PubKey, 0, count)
+	sigs := make([][]byte, 0, count)
+	msgs := make([][32]byte, 0, count)
+	for i := 0; i < count; i++ {
+		msg := [32]byte{'h', 'e', 'l', 'l', 'o', byte(i)}
+		priv, err := RandKey()
+		require.NoError(b, err)
+		pubkeys = append(pubkeys, priv.PublicKey())
+		sigs = append(sigs, priv.Sign(msg[:]).Marshal())
+		msgs = append(msgs, msg)
+	}
+
+	b.Run("batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			valid, err := VerifyMultipleSignatures(sigs, msgs, pubkeys)
+			if err != nil || !valid {
+				b.Fatal("Signatures did not verify")
+			}
+		}
+	})
+
+	b.Run("one by one", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := range sigs {
+				valid, err := VerifySignature(sigs[j], msgs[j], pubkeys[j])
+				if err != nil || !valid {
+					b.Fatal("Signature did not verify")
+				}
+			}
+		}
+	})
+}
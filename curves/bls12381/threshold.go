@@ -0,0 +1,180 @@
+package blst
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// SecretKeyShare is one of the shares of a secret key split with
+// SplitSecretKey. Index is the non-zero point the sharing polynomial was
+// evaluated at.
+type SecretKeyShare struct {
+	Index uint32
+	Key   SecretKey
+}
+
+// PartialSignature is a signature produced with a SecretKeyShare, tagged with
+// the index of the share.
+type PartialSignature struct {
+	Index     uint32
+	Signature SignatureI
+}
+
+// SplitSecretKey splits the secret key into n shares using Shamir secret
+// sharing, such that any threshold of them can produce signatures that combine
+// into a signature of the original key. Share indices run from 1 to n.
+func SplitSecretKey(secKey SecretKey, threshold, n int) ([]SecretKeyShare, error) {
+	if threshold < 1 {
+		return nil, errors.New("threshold must be at least 1")
+	}
+	if n < threshold {
+		return nil, fmt.Errorf("number of shares %d is less than threshold %d", n, threshold)
+	}
+	if uint64(n) > uint64(^uint32(0)) {
+		return nil, fmt.Errorf("number of shares %d is too large", n)
+	}
+
+	secret, err := rawSecretKey(secKey)
+	if err != nil {
+		return nil, err
+	}
+	// coeffs[0] is the secret, the others are random and wiped once the
+	// shares are computed.
+	coeffs := make([]*blst.Scalar, threshold)
+	coeffs[0] = secret
+	defer func() {
+		for _, coeff := range coeffs[1:] {
+			if coeff != nil {
+				coeff.Zeroize()
+			}
+		}
+	}()
+	for i := 1; i < threshold; i++ {
+		coeff, err := RandKey()
+		if err != nil {
+			return nil, err
+		}
+		coeffs[i] = coeff.(*bls12SecretKey).p
+	}
+
+	shares := make([]SecretKeyShare, n)
+	for i := 0; i < n; i++ {
+		index := uint32(i + 1)
+		x := indexScalar(index)
+		// Horner's rule; the n_check flags only report intermediate zeros,
+		// so the result is checked once at the end.
+		acc := *coeffs[threshold-1]
+		for k := threshold - 2; k >= 0; k-- {
+			acc.MulAssign(x)
+			acc.AddAssign(coeffs[k])
+		}
+		share := &bls12SecretKey{p: &acc}
+		if share.isZero() {
+			// Wipe the shares computed so far rather than leave them to the
+			// garbage collector.
+			share.Zeroize()
+			for _, s := range shares[:i] {
+				s.Key.Zeroize()
+			}
+			return nil, errors.New("generated secret key share is zero")
+		}
+		shares[i] = SecretKeyShare{Index: index, Key: share}
+	}
+	return shares, nil
+}
+
+// PublicKey returns the public key of the share, against which its partial
+// signatures are verified.
+func (s SecretKeyShare) PublicKey() PubKey {
+	return s.Key.PublicKey()
+}
+
+// Sign produces a partial signature of msg. It panics with
+// ErrSecretKeyZeroized if the share key has been zeroized.
+func (s SecretKeyShare) Sign(msg []byte) PartialSignature {
+	return PartialSignature{Index: s.Index, Signature: s.Key.Sign(msg)}
+}
+
+// VerifyPartialSignature verifies a partial signature of msg against the
+// public key of the share that produced it.
+func VerifyPartialSignature(sharePubKey PubKey, msg []byte, partial PartialSignature) bool {
+	if partial.Index == 0 || partial.Signature == nil {
+		return false
+	}
+	return partial.Signature.Verify(sharePubKey, msg)
+}
+
+// CombinePartialSignatures recovers the signature of the original secret key
+// from exactly threshold partial signatures with distinct indices, using
+// Lagrange interpolation at zero. Partial signatures are assumed to have been
+// verified with VerifyPartialSignature; an invalid one yields an invalid
+// signature.
+func CombinePartialSignatures(partials []PartialSignature, threshold int) (SignatureI, error) {
+	if threshold < 1 {
+		return nil, errors.New("threshold must be at least 1")
+	}
+	if len(partials) < threshold {
+		return nil, fmt.Errorf("got %d partial signatures, need %d", len(partials), threshold)
+	}
+	partials = partials[:threshold]
+
+	indices := make([]uint32, threshold)
+	rawSigs := make([]*blstSignature, threshold)
+	seen := make(map[uint32]struct{}, threshold)
+	for i, partial := range partials {
+		if partial.Index == 0 {
+			return nil, errors.New("partial signature index must not be zero")
+		}
+		if _, ok := seen[partial.Index]; ok {
+			return nil, fmt.Errorf("duplicate partial signature index %d", partial.Index)
+		}
+		seen[partial.Index] = struct{}{}
+		sig, ok := partial.Signature.(*Signature)
+		if !ok {
+			return nil, fmt.Errorf("unsupported partial signature type %T", partial.Signature)
+		}
+		indices[i] = partial.Index
+		rawSigs[i] = sig.s
+	}
+
+	coeffs := lagrangeCoefficients(indices)
+	signature := blst.P2AffinesMult(rawSigs, coeffs, 255)
+	if signature == nil {
+		return nil, errors.New("could not combine partial signatures")
+	}
+	return &Signature{s: signature.ToAffine()}, nil
+}
+
+// lagrangeCoefficients returns the Lagrange basis polynomials for the distinct,
+// non-zero indices evaluated at zero: l_i = prod_{j != i} x_j / (x_j - x_i).
+func lagrangeCoefficients(indices []uint32) []*blst.Scalar {
+	xs := make([]*blst.Scalar, len(indices))
+	for i, index := range indices {
+		xs[i] = indexScalar(index)
+	}
+	coeffs := make([]*blst.Scalar, len(indices))
+	for i := range xs {
+		num := indexScalar(1)
+		den := indexScalar(1)
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			num.MulAssign(xs[j])
+			diff, _ := xs[j].Sub(xs[i])
+			den.MulAssign(diff)
+		}
+		coeffs[i], _ = num.Mul(den.Inverse())
+	}
+	return coeffs
+}
+
+// indexScalar returns the share index as a scalar.
+func indexScalar(index uint32) *blst.Scalar {
+	var b [scalarBytes]byte
+	binary.BigEndian.PutUint32(b[scalarBytes-4:], index)
+	return new(blst.Scalar).FromBEndian(b[:])
+}
@@ -0,0 +1,97 @@
+package blst_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	blst "github.com/cosmos/crypto/curves/bls12381"
+)
+
+func TestThreshold_SignCombine(t *testing.T) {
+	tests := []struct {
+		threshold int
+		n         int
+		subset    []int
+	}{
+		{threshold: 1, n: 1, subset: []int{0}},
+		{threshold: 2, n: 3, subset: []int{0, 2}},
+		{threshold: 3, n: 5, subset: []int{4, 1, 3}},
+		{threshold: 5, n: 5, subset: []int{0, 1, 2, 3, 4}},
+	}
+	for _, test := range tests {
+		priv, err := blst.RandKey()
+		require.NoError(t, err)
+		shares, err := blst.SplitSecretKey(priv, test.threshold, test.n)
+		require.NoError(t, err)
+		require.Len(t, shares, test.n)
+
+		msg := []byte("hello")
+		partials := make([]blst.PartialSignature, 0, len(test.subset))
+		for _, i := range test.subset {
+			partial := shares[i].Sign(msg)
+			assert.Equal(t, uint32(i+1), partial.Index)
+			assert.True(t, blst.VerifyPartialSignature(shares[i].PublicKey(), msg, partial), "Partial signature did not verify")
+			partials = append(partials, partial)
+		}
+
+		sig, err := blst.CombinePartialSignatures(partials, test.threshold)
+		require.NoError(t, err)
+		assert.True(t, sig.Verify(priv.PublicKey(), msg), "Signature did not verify")
+		// BLS signatures are deterministic, so the group signature is exactly the
+		// signature of the original key.
+		assert.Equal(t, priv.Sign(msg).Marshal(), sig.Marshal())
+	}
+}
+
+func TestThreshold_BelowThreshold(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	shares, err := blst.SplitSecretKey(priv, 3, 5)
+	require.NoError(t, err)
+	msg := []byte("hello")
+
+	partials := []blst.PartialSignature{shares[0].Sign(msg), shares[1].Sign(msg)}
+	_, err = blst.CombinePartialSignatures(partials, 3)
+	assert.ErrorContains(t, err, "got 2 partial signatures, need 3")
+
+	// Interpolating too few shares yields an unrelated signature.
+	sig, err := blst.CombinePartialSignatures(partials, 2)
+	require.NoError(t, err)
+	assert.False(t, sig.Verify(priv.PublicKey(), msg), "Signature did verify")
+}
+
+func TestThreshold_InvalidPartials(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	shares, err := blst.SplitSecretKey(priv, 2, 3)
+	require.NoError(t, err)
+	msg := []byte("hello")
+
+	partial := shares[0].Sign(msg)
+	assert.False(t, blst.VerifyPartialSignature(shares[1].PublicKey(), msg, partial), "Partial signature did verify")
+	assert.False(t, blst.VerifyPartialSignature(shares[0].PublicKey(), []byte("olleh"), partial), "Partial signature did verify")
+
+	_, err = blst.CombinePartialSignatures([]blst.PartialSignature{partial, partial}, 2)
+	assert.ErrorContains(t, err, "duplicate partial signature index 1")
+	zero := blst.PartialSignature{Index: 0, Signature: partial.Signature}
+	_, err = blst.CombinePartialSignatures([]blst.PartialSignature{zero, shares[1].Sign(msg)}, 2)
+	assert.ErrorContains(t, err, "index must not be zero")
+
+	// A partial signature from the wrong share yields an invalid signature.
+	wrong := blst.PartialSignature{Index: 2, Signature: shares[2].Sign(msg).Signature}
+	sig, err := blst.CombinePartialSignatures([]blst.PartialSignature{partial, wrong}, 2)
+	require.NoError(t, err)
+	assert.False(t, sig.Verify(priv.PublicKey(), msg), "Signature did verify")
+}
+
+func TestSplitSecretKey_InvalidParams(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+
+	_, err = blst.SplitSecretKey(priv, 0, 3)
+	assert.ErrorContains(t, err, "threshold must be at least 1")
+	_, err = blst.SplitSecretKey(priv, 4, 3)
+	assert.ErrorContains(t, err, "number of shares 3 is less than threshold 4")
+}
@@ -0,0 +1,48 @@
+// Package vrf implements a verifiable random function on top of BLS12-381
+// unique signatures.
+//
+// The proof for an input alpha is the BLS signature of alpha under a
+// VRF-specific domain separation tag, and the output beta is the SHA-256 hash
+// of the proof. Since BLS signatures are deterministic and unique for a valid
+// public key, every key has exactly one output per input.
+package vrf
+
+import (
+	"crypto/sha256"
+
+	blst "github.com/cosmos/crypto/curves/bls12381"
+)
+
+const (
+	// OutputSize is the byte length of a VRF output.
+	OutputSize = sha256.Size
+	// ProofSize is the byte length of a VRF proof.
+	ProofSize = blst.SignatureLength
+)
+
+var dst = []byte("BLS_VRF_BLS12381G2_XMD:SHA-256_SSWU_RO_")
+
+// Prove computes the VRF output for alpha and the proof that it was computed
+// with the secret key. It returns blst.ErrSecretKeyZeroized if the secret key
+// has been zeroized.
+func Prove(secKey blst.SecretKey, alpha []byte) (beta [OutputSize]byte, proof []byte, err error) {
+	sig, err := secKey.SignWithDST(alpha, dst)
+	if err != nil {
+		return beta, nil, err
+	}
+	proof = sig.Marshal()
+	return sha256.Sum256(proof), proof, nil
+}
+
+// Verify checks the proof for alpha against the public key and returns the VRF
+// output. The output must only be used if ok is true.
+func Verify(pubKey blst.PubKey, alpha []byte, proof []byte) (beta [OutputSize]byte, ok bool) {
+	sig, err := blst.SignatureFromBytes(proof)
+	if err != nil {
+		return beta, false
+	}
+	if !sig.VerifyWithDST(pubKey, alpha, dst) {
+		return beta, false
+	}
+	return sha256.Sum256(proof), true
+}
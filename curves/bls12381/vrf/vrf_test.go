@@ -0,0 +1,67 @@
+package vrf_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	blst "github.com/cosmos/crypto/curves/bls12381"
+	"github.com/cosmos/crypto/curves/bls12381/vrf"
+)
+
+func TestProveVerify(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	pub := priv.PublicKey()
+	alpha := []byte("round 42")
+
+	beta, proof, err := vrf.Prove(priv, alpha)
+	require.NoError(t, err)
+	assert.Len(t, proof, vrf.ProofSize)
+
+	verified, ok := vrf.Verify(pub, alpha, proof)
+	require.True(t, ok, "Proof did not verify")
+	assert.Equal(t, beta, verified)
+
+	// The output is unique for a given key and input.
+	beta2, proof2, err := vrf.Prove(priv, alpha)
+	require.NoError(t, err)
+	assert.Equal(t, beta, beta2)
+	assert.Equal(t, proof, proof2)
+
+	otherBeta, _, err := vrf.Prove(priv, []byte("round 43"))
+	require.NoError(t, err)
+	assert.NotEqual(t, beta, otherBeta)
+}
+
+func TestVerify_Invalid(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	other, err := blst.RandKey()
+	require.NoError(t, err)
+	alpha := []byte("round 42")
+	_, proof, err := vrf.Prove(priv, alpha)
+	require.NoError(t, err)
+
+	_, ok := vrf.Verify(other.PublicKey(), alpha, proof)
+	assert.False(t, ok, "Proof verified under another key")
+	_, ok = vrf.Verify(priv.PublicKey(), []byte("round 43"), proof)
+	assert.False(t, ok, "Proof verified for another input")
+	_, ok = vrf.Verify(priv.PublicKey(), alpha, proof[1:])
+	assert.False(t, ok, "Truncated proof verified")
+
+	// A plain signature of alpha is not a VRF proof.
+	_, ok = vrf.Verify(priv.PublicKey(), alpha, priv.Sign(alpha).Marshal())
+	assert.False(t, ok, "Signature verified as a proof")
+}
+
+func TestProve_Zeroized(t *testing.T) {
+	priv, err := blst.RandKey()
+	require.NoError(t, err)
+	priv.Zeroize()
+
+	_, proof, err := vrf.Prove(priv, []byte("round 42"))
+	assert.ErrorIs(t, err, blst.ErrSecretKeyZeroized)
+	assert.Nil(t, proof)
+}
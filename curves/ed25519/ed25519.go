@@ -0,0 +1,207 @@
+package ed25519
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/hdevalence/ed25519consensus"
+
+	"github.com/cosmos/crypto/hash/sha256"
+	cmtjson "github.com/cosmos/crypto/internal/libs/json"
+	"github.com/cosmos/crypto/random"
+	"github.com/cosmos/crypto/types"
+)
+
+var (
+	_ types.PrivKey[PubKey] = PrivKey{}
+	_ types.PubKey          = PubKey{}
+)
+
+const (
+	PrivKeyName = "tendermint/PrivKeyEd25519"
+	PubKeyName  = "tendermint/PubKeyEd25519"
+	// PubKeySize is the size, in bytes, of public keys as used in this package.
+	PubKeySize = ed25519.PublicKeySize
+	// PrivateKeySize is the size, in bytes, of private keys as used in this package.
+	PrivateKeySize = ed25519.PrivateKeySize
+	// SignatureSize is the size of an Edwards25519 signature. Namely the size of a compressed
+	// Edwards25519 point, and a field element. Both of which are 32 bytes.
+	SignatureSize = ed25519.SignatureSize
+	// SeedSize is the size, in bytes, of private key seeds. These are the
+	// private key representations used by RFC 8032.
+	SeedSize = ed25519.SeedSize
+
+	KeyType = "ed25519"
+)
+
+func init() {
+	cmtjson.RegisterType(PubKey{}, PubKeyName)
+	cmtjson.RegisterType(PrivKey{}, PrivKeyName)
+}
+
+// PrivKey implements types.PrivKey.
+type PrivKey []byte
+
+// Bytes returns the privkey byte format.
+func (privKey PrivKey) Bytes() []byte {
+	return []byte(privKey)
+}
+
+// Sign produces a signature on the provided message.
+// This assumes the privkey is wellformed in the golang format.
+// The first 32 bytes should be random,
+// corresponding to the normal ed25519 private key.
+// The latter 32 bytes should be the compressed public key.
+// If these conditions aren't met, Sign will return an error or produce an
+// incorrect signature.
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	if len(privKey) != PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key length: got %d, want %d", len(privKey), PrivateKeySize)
+	}
+	signatureBytes := ed25519.Sign(ed25519.PrivateKey(privKey), msg)
+	return signatureBytes, nil
+}
+
+// PubKey gets the corresponding public key from the private key.
+//
+// Panics if the private key is not initialized.
+func (privKey PrivKey) PubKey() PubKey {
+	// If the latter 32 bytes of the privkey are all zero, privkey is not
+	// initialized.
+	initialized := false
+	for _, v := range privKey[32:] {
+		if v != 0 {
+			initialized = true
+			break
+		}
+	}
+
+	if !initialized {
+		panic("Expected ed25519 PrivKey to include concatenated pubkey bytes")
+	}
+
+	pubkeyBytes := make([]byte, PubKeySize)
+	copy(pubkeyBytes, privKey[32:])
+	return PubKey(pubkeyBytes)
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKey) Equals(other types.PrivKey[PubKey]) bool {
+	if otherEd, ok := other.(PrivKey); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherEd[:]) == 1
+	}
+
+	return false
+}
+
+func (PrivKey) Type() string {
+	return KeyType
+}
+
+// GenPrivKey generates a new ed25519 private key.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() PrivKey {
+	return genPrivKey(random.CReader())
+}
+
+// genPrivKey generates a new ed25519 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKey {
+	_, priv, err := ed25519.GenerateKey(rand)
+	if err != nil {
+		panic(err)
+	}
+
+	return PrivKey(priv)
+}
+
+// GenPrivKeyFromSeed creates a new private key deterministically from the
+// RFC 8032 seed passed as parameter.
+func GenPrivKeyFromSeed(seed [SeedSize]byte) PrivKey {
+	return PrivKey(ed25519.NewKeyFromSeed(seed[:]))
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses
+// that 32 byte output to create the private key.
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) PrivKey {
+	seed := sha256.Sum(secret) // Not Ripemd160 because we want 32 bytes.
+
+	return PrivKey(ed25519.NewKeyFromSeed(seed))
+}
+
+// PrivKeyFromBytes creates an ed25519 private key from its 64-byte
+// representation, checking that the embedded public key matches the seed.
+func PrivKeyFromBytes(bz []byte) (PrivKey, error) {
+	if len(bz) != PrivateKeySize {
+		return nil, fmt.Errorf("private key must be %d bytes", PrivateKeySize)
+	}
+	privKey := ed25519.NewKeyFromSeed(bz[:SeedSize])
+	if !bytes.Equal(privKey[SeedSize:], bz[SeedSize:]) {
+		return nil, errors.New("private key does not match its public key")
+	}
+	return PrivKey(privKey), nil
+}
+
+//-------------------------------------
+
+// PubKey implements types.PubKey for the Ed25519 signature scheme.
+type PubKey []byte
+
+// Address is the SHA256-20 of the raw pubkey bytes.
+func (pubKey PubKey) Address() types.Address {
+	if len(pubKey) != PubKeySize {
+		panic("pubkey is incorrect size")
+	}
+	return types.AddressHash(pubKey)
+}
+
+// Bytes returns the PubKey byte format.
+func (pubKey PubKey) Bytes() []byte {
+	return []byte(pubKey)
+}
+
+// VerifySignature verifies a signature using the ZIP-215 validation rules,
+// so that all nodes agree on the validity of edge-case signatures.
+func (pubKey PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	// make sure we use the same algorithm to sign
+	if len(sig) != SignatureSize {
+		return false
+	}
+	if len(pubKey) != PubKeySize {
+		return false
+	}
+
+	return ed25519consensus.Verify(ed25519.PublicKey(pubKey), msg, sig)
+}
+
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeyEd25519{%X}", []byte(pubKey))
+}
+
+func (PubKey) Type() string {
+	return KeyType
+}
+
+func (pubKey PubKey) Equals(other types.PubKey) bool {
+	if otherEd, ok := other.(PubKey); ok {
+		return bytes.Equal(pubKey[:], otherEd[:])
+	}
+
+	return false
+}
+
+// PubKeyFromBytes creates an ed25519 public key from its 32-byte representation.
+func PubKeyFromBytes(bz []byte) (PubKey, error) {
+	if len(bz) != PubKeySize {
+		return nil, fmt.Errorf("public key must be %d bytes", PubKeySize)
+	}
+	pubKey := make([]byte, PubKeySize)
+	copy(pubKey, bz)
+	return PubKey(pubKey), nil
+}
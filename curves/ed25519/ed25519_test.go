@@ -0,0 +1,102 @@
+package ed25519
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/crypto/types"
+)
+
+func TestSignAndValidateEd25519(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := []byte("hello crypto")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+
+	// Test the signature
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+
+	// Mutate the signature, just one bit.
+	sig[7] ^= byte(0x01)
+
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+}
+
+func TestGenPrivKeyFromSeed(t *testing.T) {
+	// Test vector 1 from RFC 8032, section 7.1.
+	seed, err := hex.DecodeString("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+	require.NoError(t, err)
+	wantPub, err := hex.DecodeString("d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a")
+	require.NoError(t, err)
+	wantSig, err := hex.DecodeString("e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b")
+	require.NoError(t, err)
+
+	privKey := GenPrivKeyFromSeed([SeedSize]byte(seed))
+	assert.Equal(t, wantPub, privKey.PubKey().Bytes())
+
+	sig, err := privKey.Sign(nil)
+	require.NoError(t, err)
+	assert.Equal(t, wantSig, sig)
+
+	privKey2 := GenPrivKeyFromSeed([SeedSize]byte(seed))
+	assert.True(t, privKey.Equals(privKey2))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	secret := []byte("this is my little key")
+	privKeyA := GenPrivKeyFromSecret(secret)
+	privKeyB := GenPrivKeyFromSecret(secret)
+	privKeyC := GenPrivKeyFromSecret([]byte("another secret"))
+
+	assert.True(t, privKeyA.Equals(privKeyB))
+	assert.False(t, privKeyA.Equals(privKeyC))
+}
+
+func TestVerifySignatureZIP215(t *testing.T) {
+	// A public key of small order, paired with the identity point as R and a
+	// zero scalar, is valid under the cofactored ZIP-215 equation for any message.
+	pubKey, err := hex.DecodeString("c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a")
+	require.NoError(t, err)
+	sig := make([]byte, SignatureSize)
+	sig[0] = 0x01
+
+	assert.True(t, PubKey(pubKey).VerifySignature([]byte("zip215"), sig))
+}
+
+func TestPubKeyAddressAndEquals(t *testing.T) {
+	pubKey := GenPrivKey().PubKey()
+
+	assert.Equal(t, types.AddressHash(pubKey.Bytes()), pubKey.Address())
+	assert.Len(t, pubKey.Address(), types.AddressSize)
+	assert.Equal(t, KeyType, pubKey.Type())
+
+	pubKey2, err := PubKeyFromBytes(pubKey.Bytes())
+	require.NoError(t, err)
+	assert.True(t, pubKey.Equals(pubKey2))
+	assert.False(t, pubKey.Equals(GenPrivKey().PubKey()))
+
+	_, err = PubKeyFromBytes(pubKey.Bytes()[1:])
+	assert.ErrorContains(t, err, "public key must be 32 bytes")
+}
+
+func TestPrivKeyFromBytes(t *testing.T) {
+	privKey := GenPrivKey()
+
+	privKey2, err := PrivKeyFromBytes(privKey.Bytes())
+	require.NoError(t, err)
+	assert.True(t, privKey.Equals(privKey2))
+
+	corrupted := make([]byte, PrivateKeySize)
+	copy(corrupted, privKey.Bytes())
+	corrupted[PrivateKeySize-1] ^= 0x01
+	_, err = PrivKeyFromBytes(corrupted)
+	assert.ErrorContains(t, err, "private key does not match its public key")
+
+	_, err = PrivKeyFromBytes(privKey.Bytes()[:SeedSize])
+	assert.ErrorContains(t, err, "private key must be 64 bytes")
+}
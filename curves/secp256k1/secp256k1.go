@@ -0,0 +1,239 @@
+package secp256k1
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+
+	"github.com/cosmos/crypto/hash/ripemd160"
+	"github.com/cosmos/crypto/hash/sha256"
+	cmtjson "github.com/cosmos/crypto/internal/libs/json"
+	"github.com/cosmos/crypto/random"
+	"github.com/cosmos/crypto/types"
+)
+
+var (
+	_ types.PrivKey[PubKey] = PrivKey{}
+	_ types.PubKey          = PubKey{}
+)
+
+const (
+	PrivKeyName = "tendermint/PrivKeySecp256k1"
+	PubKeyName  = "tendermint/PubKeySecp256k1"
+
+	KeyType     = "secp256k1"
+	PrivKeySize = 32
+	// PubKeySize is comprised of 32 bytes for one field element
+	// (the x-coordinate), plus one byte for the parity of the y-coordinate.
+	PubKeySize = 33
+	// SignatureSize is the size of a signature in its compact r || s form.
+	SignatureSize = 64
+)
+
+func init() {
+	cmtjson.RegisterType(PubKey{}, PubKeyName)
+	cmtjson.RegisterType(PrivKey{}, PrivKeyName)
+}
+
+// PrivKey implements types.PrivKey.
+type PrivKey []byte
+
+// Bytes returns the privkey byte format.
+func (privKey PrivKey) Bytes() []byte {
+	return []byte(privKey)
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point to get the pubkey.
+func (privKey PrivKey) PubKey() PubKey {
+	secpPrivKey := secp256k1.PrivKeyFromBytes(privKey)
+	pk := secpPrivKey.PubKey().SerializeCompressed()
+	return PubKey(pk)
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKey) Equals(other types.PrivKey[PubKey]) bool {
+	if otherSecp, ok := other.(PrivKey); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherSecp[:]) == 1
+	}
+	return false
+}
+
+func (PrivKey) Type() string {
+	return KeyType
+}
+
+// Sign creates an ECDSA signature on curve Secp256k1, using SHA256 on the msg.
+// The returned signature will be of the form R || S (in lower-S form).
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	if len(privKey) != PrivKeySize {
+		return nil, fmt.Errorf("invalid private key length: got %d, want %d", len(privKey), PrivKeySize)
+	}
+	priv := secp256k1.PrivKeyFromBytes(privKey)
+	sig := ecdsa.SignCompact(priv, sha256.Sum(msg), false)
+
+	// remove the first byte which is compactSigRecoveryCode
+	return sig[1:], nil
+}
+
+// GenPrivKey generates a new ECDSA private key on curve secp256k1 private key.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() PrivKey {
+	return genPrivKey(random.CReader())
+}
+
+// genPrivKey generates a new secp256k1 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKey {
+	var privKeyBytes [PrivKeySize]byte
+	d := new(secp256k1.ModNScalar)
+	for {
+		if _, err := io.ReadFull(rand, privKeyBytes[:]); err != nil {
+			panic(err)
+		}
+
+		// Make sure the key is in the range [1, N-1] without a modular
+		// reduction, so that the distribution stays uniform.
+		overflow := d.SetBytes(&privKeyBytes)
+		if overflow == 0 && !d.IsZero() {
+			break
+		}
+	}
+
+	return PrivKey(privKeyBytes[:])
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses
+// that 32 byte output to create the private key.
+//
+// It makes sure the private key is a valid field element by setting:
+//
+// c = sha256(secret)
+// k = (c mod (n − 1)) + 1, where n = curve order.
+//
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) PrivKey {
+	secHash := sha256.Sum(secret)
+	// to guarantee that we have a valid field element, we use the approach of:
+	// "Suite B Implementer’s Guide to FIPS 186-3", A.2.1
+	// https://apps.nsa.gov/iaarchive/library/ia-guidance/ia-solutions-for-classified/algorithm-guidance/suite-b-implementers-guide-to-fips-186-3-ecdsa.cfm
+	// see also https://github.com/golang/go/blob/0380c9ad38843d523d9c9804fe300cb7edd7cd3c/src/crypto/ecdsa/ecdsa.go#L89-L101
+	one := new(big.Int).SetInt64(1)
+	n := new(big.Int).Sub(secp256k1.S256().N, one)
+	k := new(big.Int).SetBytes(secHash)
+	k.Mod(k, n)
+	k.Add(k, one)
+
+	return PrivKey(k.FillBytes(make([]byte, PrivKeySize)))
+}
+
+// PrivKeyFromBytes creates a secp256k1 private key from its 32-byte
+// big-endian representation, checking that it is a valid scalar.
+func PrivKeyFromBytes(bz []byte) (PrivKey, error) {
+	if len(bz) != PrivKeySize {
+		return nil, fmt.Errorf("private key must be %d bytes", PrivKeySize)
+	}
+	d := new(secp256k1.ModNScalar)
+	if overflow := d.SetByteSlice(bz); overflow || d.IsZero() {
+		return nil, errors.New("private key is not a valid scalar")
+	}
+	privKey := make([]byte, PrivKeySize)
+	copy(privKey, bz)
+	return PrivKey(privKey), nil
+}
+
+//-------------------------------------
+
+// PubKey implements types.PubKey.
+// It is the compressed form of the pubkey. The first byte is a 0x02 byte
+// if the y-coordinate is even, otherwise the first byte is a 0x03.
+// This prefix is followed with the x-coordinate.
+type PubKey []byte
+
+// Address returns a Bitcoin style address: RIPEMD160(SHA256(pubkey)).
+func (pubKey PubKey) Address() types.Address {
+	if len(pubKey) != PubKeySize {
+		panic("length of pubkey is incorrect")
+	}
+
+	return types.Address(ripemd160.Sum(sha256.Sum(pubKey)))
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey PubKey) Bytes() []byte {
+	return []byte(pubKey)
+}
+
+// VerifySignature verifies a signature of the form R || S.
+// It rejects signatures which are not in lower-S form.
+func (pubKey PubKey) VerifySignature(msg []byte, sigStr []byte) bool {
+	if len(sigStr) != SignatureSize {
+		return false
+	}
+	pub, err := secp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return false
+	}
+	// parse the signature:
+	signature := signatureFromBytes(sigStr)
+	if signature == nil {
+		return false
+	}
+
+	return signature.Verify(sha256.Sum(msg), pub)
+}
+
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeySecp256k1{%X}", []byte(pubKey))
+}
+
+func (PubKey) Type() string {
+	return KeyType
+}
+
+func (pubKey PubKey) Equals(other types.PubKey) bool {
+	if otherSecp, ok := other.(PubKey); ok {
+		return bytes.Equal(pubKey[:], otherSecp[:])
+	}
+	return false
+}
+
+// PubKeyFromBytes creates a secp256k1 public key from its 33-byte compressed
+// representation, checking that it is a point on the curve.
+func PubKeyFromBytes(bz []byte) (PubKey, error) {
+	if len(bz) != PubKeySize {
+		return nil, fmt.Errorf("public key must be %d bytes", PubKeySize)
+	}
+	if _, err := secp256k1.ParsePubKey(bz); err != nil {
+		return nil, fmt.Errorf("could not unmarshal bytes into public key: %w", err)
+	}
+	pubKey := make([]byte, PubKeySize)
+	copy(pubKey, bz)
+	return PubKey(pubKey), nil
+}
+
+// signatureFromBytes parses a 64-byte R || S signature. Returns nil if
+// either scalar overflows the group order or if S is not in lower-S form,
+// to prevent signature malleability.
+func signatureFromBytes(sigStr []byte) *ecdsa.Signature {
+	var r, s secp256k1.ModNScalar
+	if r.SetByteSlice(sigStr[:32]) {
+		return nil // overflow
+	}
+	if s.SetByteSlice(sigStr[32:64]) {
+		return nil
+	}
+	// Reject malleable signatures. libsecp256k1 does this check but decred doesn't.
+	if s.IsOverHalfOrder() {
+		return nil
+	}
+
+	return ecdsa.NewSignature(&r, &s)
+}
@@ -0,0 +1,114 @@
+package secp256k1
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/crypto/types"
+)
+
+func TestSignAndValidateSecp256k1(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), PubKeySize)
+
+	msg := []byte("hello crypto")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, SignatureSize)
+
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+	assert.False(t, pubKey.VerifySignature([]byte("another message"), sig))
+
+	// Mutate the signature, just one bit.
+	sig[3] ^= byte(0x01)
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+}
+
+func TestVerifySignatureRejectsHighS(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := []byte("malleable")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+
+	// (r, n - s) is an equally valid ECDSA signature which must be rejected.
+	s := new(big.Int).SetBytes(sig[32:])
+	highS := new(big.Int).Sub(secp256k1.S256().N, s)
+	malleated := make([]byte, SignatureSize)
+	copy(malleated, sig[:32])
+	highS.FillBytes(malleated[32:])
+
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+	assert.False(t, pubKey.VerifySignature(msg, malleated))
+}
+
+func TestPubKeyAddress(t *testing.T) {
+	// The public key of the private key 1 is the generator point, whose
+	// Bitcoin HASH160 is well known.
+	privKey, err := PrivKeyFromBytes(append(make([]byte, PrivKeySize-1), 0x01))
+	require.NoError(t, err)
+	pubKey := privKey.PubKey()
+
+	assert.Equal(t, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", hex.EncodeToString(pubKey.Bytes()))
+	assert.Equal(t, "751e76e8199196d454941c45d1b3a323f1433bd6", hex.EncodeToString(pubKey.Address()))
+	assert.Len(t, pubKey.Address(), types.AddressSize)
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	secret := []byte("this is my little key")
+	privKeyA := GenPrivKeyFromSecret(secret)
+	privKeyB := GenPrivKeyFromSecret(secret)
+	privKeyC := GenPrivKeyFromSecret([]byte("another secret"))
+
+	assert.True(t, privKeyA.Equals(privKeyB))
+	assert.False(t, privKeyA.Equals(privKeyC))
+
+	_, err := PrivKeyFromBytes(privKeyA.Bytes())
+	assert.NoError(t, err)
+}
+
+func TestKeysFromBytes(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{name: "Short", input: "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f817", err: "public key must be 33 bytes"},
+		{name: "Not on curve", input: "020000000000000000000000000000000000000000000000000000000000000005", err: "could not unmarshal bytes into public key"},
+		{name: "Good", input: "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bz, err := hex.DecodeString(test.input)
+			require.NoError(t, err)
+			pubKey, err := PubKeyFromBytes(bz)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, bz, pubKey.Bytes())
+		})
+	}
+
+	_, err := PrivKeyFromBytes(make([]byte, PrivKeySize))
+	assert.ErrorContains(t, err, "private key is not a valid scalar")
+	_, err = PrivKeyFromBytes(secp256k1.S256().N.Bytes())
+	assert.ErrorContains(t, err, "private key is not a valid scalar")
+}
+
+func TestPubKeyEquals(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	assert.True(t, pubKey.Equals(privKey.PubKey()))
+	assert.False(t, pubKey.Equals(GenPrivKey().PubKey()))
+	assert.Equal(t, KeyType, pubKey.Type())
+}
@@ -0,0 +1,311 @@
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/subtle"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/cosmos/crypto/hash/sha256"
+	cmtjson "github.com/cosmos/crypto/internal/libs/json"
+	"github.com/cosmos/crypto/random"
+	"github.com/cosmos/crypto/types"
+)
+
+var (
+	_ types.PrivKey[PubKey] = PrivKey{}
+	_ types.PubKey          = PubKey{}
+)
+
+const (
+	PrivKeyName = "cosmos/PrivKeySecp256r1"
+	PubKeyName  = "cosmos/PubKeySecp256r1"
+
+	KeyType     = "secp256r1"
+	PrivKeySize = 32
+	// PubKeySize is comprised of 32 bytes for the x-coordinate, plus one
+	// byte for the parity of the y-coordinate (SEC 1 compressed form).
+	PubKeySize = 33
+	// SignatureSize is the size of a signature in its raw r || s form.
+	SignatureSize = 64
+)
+
+var (
+	curve     = elliptic.P256()
+	order     = curve.Params().N
+	halfOrder = new(big.Int).Rsh(order, 1)
+)
+
+func init() {
+	cmtjson.RegisterType(PubKey{}, PubKeyName)
+	cmtjson.RegisterType(PrivKey{}, PrivKeyName)
+}
+
+// PrivKey implements types.PrivKey. It is the 32-byte big-endian scalar.
+type PrivKey []byte
+
+// Bytes returns the privkey byte format.
+func (privKey PrivKey) Bytes() []byte {
+	return []byte(privKey)
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point to get the pubkey.
+//
+// Panics if the private key is not a valid scalar.
+func (privKey PrivKey) PubKey() PubKey {
+	priv, err := privKey.toECDSA()
+	if err != nil {
+		panic(err)
+	}
+	return PubKey(elliptic.MarshalCompressed(curve, priv.X, priv.Y))
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKey) Equals(other types.PrivKey[PubKey]) bool {
+	if otherR1, ok := other.(PrivKey); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherR1[:]) == 1
+	}
+	return false
+}
+
+func (PrivKey) Type() string {
+	return KeyType
+}
+
+// Sign creates an ECDSA signature on curve P-256, using SHA256 on the msg.
+// The returned signature will be of the form R || S (in lower-S form).
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	priv, err := privKey.toECDSA()
+	if err != nil {
+		return nil, err
+	}
+	r, s, err := ecdsa.Sign(random.CReader(), priv, sha256.Sum(msg))
+	if err != nil {
+		return nil, err
+	}
+	// Normalize S to the lower half of the order to prevent malleability.
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(order, s)
+	}
+
+	sig := make([]byte, SignatureSize)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return sig, nil
+}
+
+// toECDSA converts the private key into its crypto/ecdsa representation.
+func (privKey PrivKey) toECDSA() (*ecdsa.PrivateKey, error) {
+	if len(privKey) != PrivKeySize {
+		return nil, fmt.Errorf("invalid private key length: got %d, want %d", len(privKey), PrivKeySize)
+	}
+	key, err := ecdh.P256().NewPrivateKey(privKey)
+	if err != nil {
+		return nil, err
+	}
+	// The uncompressed point is 0x04 || X || Y.
+	point := key.PublicKey().Bytes()
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(point[1:33]),
+			Y:     new(big.Int).SetBytes(point[33:]),
+		},
+		D: new(big.Int).SetBytes(privKey),
+	}, nil
+}
+
+// GenPrivKey generates a new ECDSA private key on curve P-256.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() PrivKey {
+	return genPrivKey(random.CReader())
+}
+
+// genPrivKey generates a new secp256r1 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKey {
+	key, err := ecdh.P256().GenerateKey(rand)
+	if err != nil {
+		panic(err)
+	}
+	return PrivKey(key.Bytes())
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses
+// that 32 byte output to create the private key.
+//
+// It makes sure the private key is a valid field element by setting:
+//
+// c = sha256(secret)
+// k = (c mod (n − 1)) + 1, where n = curve order.
+//
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) PrivKey {
+	secHash := sha256.Sum(secret)
+	one := new(big.Int).SetInt64(1)
+	n := new(big.Int).Sub(order, one)
+	k := new(big.Int).SetBytes(secHash)
+	k.Mod(k, n)
+	k.Add(k, one)
+
+	return PrivKey(k.FillBytes(make([]byte, PrivKeySize)))
+}
+
+// PrivKeyFromBytes creates a secp256r1 private key from its 32-byte
+// big-endian representation, checking that it is a valid scalar.
+func PrivKeyFromBytes(bz []byte) (PrivKey, error) {
+	if len(bz) != PrivKeySize {
+		return nil, fmt.Errorf("private key must be %d bytes", PrivKeySize)
+	}
+	if _, err := ecdh.P256().NewPrivateKey(bz); err != nil {
+		return nil, errors.New("private key is not a valid scalar")
+	}
+	privKey := make([]byte, PrivKeySize)
+	copy(privKey, bz)
+	return PrivKey(privKey), nil
+}
+
+//-------------------------------------
+
+// PubKey implements types.PubKey.
+// It is the SEC 1 compressed form of the pubkey: a 0x02 or 0x03 byte for the
+// parity of the y-coordinate, followed by the x-coordinate.
+type PubKey []byte
+
+// Address is the SHA256-20 of the compressed pubkey bytes.
+func (pubKey PubKey) Address() types.Address {
+	if len(pubKey) != PubKeySize {
+		panic("length of pubkey is incorrect")
+	}
+	return types.AddressHash(pubKey)
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey PubKey) Bytes() []byte {
+	return []byte(pubKey)
+}
+
+// VerifySignature verifies a signature over the SHA256 of msg. Only the
+// canonical raw 64-byte R || S form produced by Sign is accepted, with S in
+// the lower half of the order, so that a signature has a single valid
+// encoding.
+func (pubKey PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	// Reject malleable signatures.
+	if s.Cmp(halfOrder) > 0 {
+		return false
+	}
+	return pubKey.verify(msg, r, s)
+}
+
+// VerifyDERSignature verifies a strict ASN.1 DER signature over the SHA256 of
+// msg, as produced by WebAuthn authenticators. S is not required to be in
+// lower-S form, so the signature is malleable: it must not be used where the
+// signature bytes are expected to be unique, such as in transaction hashes.
+func (pubKey PubKey) VerifyDERSignature(msg []byte, sig []byte) bool {
+	r, s, err := parseDERSignature(sig)
+	if err != nil {
+		return false
+	}
+	return pubKey.verify(msg, r, s)
+}
+
+func (pubKey PubKey) verify(msg []byte, r, s *big.Int) bool {
+	pub, err := pubKey.toECDSA()
+	if err != nil {
+		return false
+	}
+	return ecdsa.Verify(pub, sha256.Sum(msg), r, s)
+}
+
+// toECDSA converts the public key into its crypto/ecdsa representation.
+func (pubKey PubKey) toECDSA() (*ecdsa.PublicKey, error) {
+	if len(pubKey) != PubKeySize {
+		return nil, fmt.Errorf("public key must be %d or %d bytes", PubKeySize, 1+2*32)
+	}
+	x, y := elliptic.UnmarshalCompressed(curve, pubKey)
+	if x == nil {
+		return nil, errors.New("could not unmarshal bytes into public key")
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeySecp256r1{%X}", []byte(pubKey))
+}
+
+func (PubKey) Type() string {
+	return KeyType
+}
+
+func (pubKey PubKey) Equals(other types.PubKey) bool {
+	if otherR1, ok := other.(PubKey); ok {
+		return bytes.Equal(pubKey[:], otherR1[:])
+	}
+	return false
+}
+
+// PubKeyFromBytes creates a secp256r1 public key from either its 33-byte
+// compressed or its 65-byte uncompressed SEC 1 representation, checking that
+// it is a point on the curve. The returned key is always compressed.
+func PubKeyFromBytes(bz []byte) (PubKey, error) {
+	switch len(bz) {
+	case PubKeySize:
+		pubKey := PubKey(bz)
+		if _, err := pubKey.toECDSA(); err != nil {
+			return nil, err
+		}
+		return append(PubKey(nil), bz...), nil
+	case 1 + 2*32:
+		key, err := ecdh.P256().NewPublicKey(bz)
+		if err != nil {
+			return nil, fmt.Errorf("could not unmarshal bytes into public key: %w", err)
+		}
+		point := key.Bytes()
+		x := new(big.Int).SetBytes(point[1:33])
+		y := new(big.Int).SetBytes(point[33:])
+		return PubKey(elliptic.MarshalCompressed(curve, x, y)), nil
+	default:
+		return nil, fmt.Errorf("public key must be %d or %d bytes", PubKeySize, 1+2*32)
+	}
+}
+
+// ecdsaSignature is the ASN.1 structure of a DER encoded ECDSA signature.
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// parseDERSignature decodes a strict DER ECDSA signature, rejecting trailing
+// data, non-minimal encodings and scalars outside of [1, n-1].
+func parseDERSignature(sig []byte) (r, s *big.Int, err error) {
+	var decoded ecdsaSignature
+	rest, err := asn1.Unmarshal(sig, &decoded)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(rest) != 0 {
+		return nil, nil, errors.New("trailing data after signature")
+	}
+	if decoded.R.Sign() <= 0 || decoded.S.Sign() <= 0 ||
+		decoded.R.Cmp(order) >= 0 || decoded.S.Cmp(order) >= 0 {
+		return nil, nil, errors.New("signature scalar out of range")
+	}
+	// Re-encoding must produce the exact same bytes, which rules out BER.
+	reencoded, err := asn1.Marshal(decoded)
+	if err != nil || !bytes.Equal(reencoded, sig) {
+		return nil, nil, errors.New("signature is not strict DER")
+	}
+	return decoded.R, decoded.S, nil
+}
@@ -0,0 +1,134 @@
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/crypto/hash/sha256"
+	"github.com/cosmos/crypto/types"
+)
+
+func TestSignAndValidateSecp256r1(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), PubKeySize)
+
+	msg := []byte("hello crypto")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, SignatureSize)
+
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+	assert.False(t, pubKey.VerifySignature([]byte("another message"), sig))
+	assert.False(t, GenPrivKey().PubKey().VerifySignature(msg, sig))
+
+	// Signatures are always emitted in lower-S form.
+	assert.True(t, new(big.Int).SetBytes(sig[32:]).Cmp(halfOrder) <= 0)
+
+	// Mutate the signature, just one bit.
+	sig[3] ^= byte(0x01)
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+}
+
+func TestVerifySignatureRejectsHighS(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := []byte("malleable")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+
+	s := new(big.Int).SetBytes(sig[32:])
+	malleated := make([]byte, SignatureSize)
+	copy(malleated, sig[:32])
+	new(big.Int).Sub(order, s).FillBytes(malleated[32:])
+
+	assert.False(t, pubKey.VerifySignature(msg, malleated))
+}
+
+func TestVerifyDERSignature(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+	priv, err := privKey.toECDSA()
+	require.NoError(t, err)
+
+	msg := []byte("webauthn assertion")
+	der, err := ecdsa.SignASN1(rand.Reader, priv, sha256.Sum(msg))
+	require.NoError(t, err)
+	assert.True(t, pubKey.VerifyDERSignature(msg, der))
+	// The default verifier only accepts the canonical raw encoding.
+	assert.False(t, pubKey.VerifySignature(msg, der))
+
+	// DER signatures from authenticators may be in high-S form.
+	var decoded ecdsaSignature
+	_, err = asn1.Unmarshal(der, &decoded)
+	require.NoError(t, err)
+	decoded.S.Sub(order, decoded.S)
+	flipped, err := asn1.Marshal(decoded)
+	require.NoError(t, err)
+	assert.True(t, pubKey.VerifyDERSignature(msg, flipped))
+	assert.False(t, pubKey.VerifySignature(msg, flipped))
+
+	// Trailing data is not allowed.
+	assert.False(t, pubKey.VerifyDERSignature(msg, append(der, 0x00)))
+	assert.False(t, pubKey.VerifyDERSignature([]byte("another message"), der))
+
+	// A raw signature is not accepted as DER.
+	raw, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	assert.False(t, pubKey.VerifyDERSignature(msg, raw))
+}
+
+func TestPubKeyFromBytes(t *testing.T) {
+	privKey := GenPrivKey()
+	pubKey := privKey.PubKey()
+	priv, err := privKey.toECDSA()
+	require.NoError(t, err)
+
+	compressed, err := PubKeyFromBytes(pubKey.Bytes())
+	require.NoError(t, err)
+	assert.True(t, pubKey.Equals(compressed))
+
+	uncompressed, err := priv.PublicKey.ECDH()
+	require.NoError(t, err)
+	fromUncompressed, err := PubKeyFromBytes(uncompressed.Bytes())
+	require.NoError(t, err)
+	assert.True(t, pubKey.Equals(fromUncompressed))
+
+	_, err = PubKeyFromBytes(pubKey.Bytes()[1:])
+	assert.ErrorContains(t, err, "public key must be 33 or 65 bytes")
+
+	// An x-coordinate larger than the field prime is never on the curve.
+	bad := append(PubKey{0x02}, bytes.Repeat([]byte{0xff}, 32)...)
+	_, err = PubKeyFromBytes(bad)
+	assert.ErrorContains(t, err, "could not unmarshal bytes into public key")
+}
+
+func TestPrivKeyFromBytes(t *testing.T) {
+	privKey := GenPrivKeyFromSecret([]byte("this is my little key"))
+	assert.True(t, privKey.Equals(GenPrivKeyFromSecret([]byte("this is my little key"))))
+
+	privKey2, err := PrivKeyFromBytes(privKey.Bytes())
+	require.NoError(t, err)
+	assert.True(t, privKey.Equals(privKey2))
+
+	_, err = PrivKeyFromBytes(make([]byte, PrivKeySize))
+	assert.ErrorContains(t, err, "private key is not a valid scalar")
+	_, err = PrivKeyFromBytes(order.Bytes())
+	assert.ErrorContains(t, err, "private key is not a valid scalar")
+}
+
+func TestPubKeyAddress(t *testing.T) {
+	pubKey := GenPrivKey().PubKey()
+
+	assert.Equal(t, types.AddressHash(pubKey.Bytes()), pubKey.Address())
+	assert.Len(t, pubKey.Address(), types.AddressSize)
+	assert.Equal(t, KeyType, pubKey.Type())
+}
@@ -3,14 +3,20 @@ module github.com/cosmos/crypto
 go 1.22.2
 
 require (
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.3.0
+	github.com/hdevalence/ed25519consensus v0.2.0
 	github.com/sasha-s/go-deadlock v0.3.1
 	github.com/stretchr/testify v1.9.0
 	github.com/supranational/blst v0.3.12
+	github.com/zeebo/blake3 v0.2.4
 	golang.org/x/crypto v0.24.0
+	golang.org/x/text v0.16.0
 )
 
 require (
+	filippo.io/edwards25519 v1.0.0 // indirect
 	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
+	github.com/klauspost/cpuid/v2 v2.0.12 // indirect
 	github.com/petermattis/goid v0.0.0-20180202154549-b0b1615b78e5 // indirect
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
 	golang.org/x/sys v0.21.0 // indirect
@@ -0,0 +1,37 @@
+// Package blake2b implements unkeyed BLAKE2b with a 256-bit output.
+package blake2b
+
+import (
+	"hash"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	Size      = blake2b.Size256
+	BlockSize = blake2b.BlockSize
+)
+
+// New returns a new hash.Hash.
+func New() hash.Hash {
+	h, _ := blake2b.New256(nil) // only fails for keys longer than 64 bytes
+	return h
+}
+
+// Sum returns the BLAKE2b-256 of the bz.
+func Sum(bz []byte) []byte {
+	h := blake2b.Sum256(bz)
+	return h[:]
+}
+
+// SumMany takes at least 1 byteslice along with a variadic
+// number of other byteslices and produces the BLAKE2b-256 sum from
+// hashing them as if they were 1 joined slice.
+func SumMany(data []byte, rest ...[]byte) []byte {
+	h := New()
+	h.Write(data)
+	for _, data := range rest {
+		h.Write(data)
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,29 @@
+package blake2b
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHash(t *testing.T) {
+	testVector := []byte("abc")
+	hasher := New()
+	_, err := hasher.Write(testVector)
+	require.NoError(t, err)
+	bz := hasher.Sum(nil)
+
+	bz2 := Sum(testVector)
+
+	assert.Equal(t, "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319", hex.EncodeToString(bz))
+	assert.Equal(t, bz, bz2)
+	assert.Len(t, bz, Size)
+	assert.Equal(t, BlockSize, hasher.BlockSize())
+}
+
+func TestSumMany(t *testing.T) {
+	assert.Equal(t, Sum([]byte("abc")), SumMany([]byte("a"), []byte("b"), nil, []byte("c")))
+	assert.Equal(t, Sum(nil), SumMany(nil))
+}
@@ -0,0 +1,36 @@
+package blake2s
+
+import (
+	"hash"
+
+	"golang.org/x/crypto/blake2s"
+)
+
+const (
+	Size      = blake2s.Size
+	BlockSize = blake2s.BlockSize
+)
+
+// New returns a new hash.Hash.
+func New() hash.Hash {
+	h, _ := blake2s.New256(nil) // only fails for keys longer than 32 bytes
+	return h
+}
+
+// Sum returns the BLAKE2s-256 of the bz.
+func Sum(bz []byte) []byte {
+	h := blake2s.Sum256(bz)
+	return h[:]
+}
+
+// SumMany takes at least 1 byteslice along with a variadic
+// number of other byteslices and produces the BLAKE2s-256 sum from
+// hashing them as if they were 1 joined slice.
+func SumMany(data []byte, rest ...[]byte) []byte {
+	h := New()
+	h.Write(data)
+	for _, data := range rest {
+		h.Write(data)
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,29 @@
+package blake2s
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHash(t *testing.T) {
+	testVector := []byte("abc")
+	hasher := New()
+	_, err := hasher.Write(testVector)
+	require.NoError(t, err)
+	bz := hasher.Sum(nil)
+
+	bz2 := Sum(testVector)
+
+	assert.Equal(t, "508c5e8c327c14e2e1a72ba34eeb452f37458b209ed63a294d999b4c86675982", hex.EncodeToString(bz))
+	assert.Equal(t, bz, bz2)
+	assert.Len(t, bz, Size)
+	assert.Equal(t, BlockSize, hasher.BlockSize())
+}
+
+func TestSumMany(t *testing.T) {
+	assert.Equal(t, Sum([]byte("abc")), SumMany([]byte("a"), []byte("b"), nil, []byte("c")))
+	assert.Equal(t, Sum(nil), SumMany(nil))
+}
@@ -0,0 +1,36 @@
+// Package blake3 implements unkeyed BLAKE3 with the default 256-bit output.
+package blake3
+
+import (
+	"hash"
+
+	"github.com/zeebo/blake3"
+)
+
+const (
+	Size      = 32
+	BlockSize = 64
+)
+
+// New returns a new hash.Hash.
+func New() hash.Hash {
+	return blake3.New()
+}
+
+// Sum returns the BLAKE3 of the bz.
+func Sum(bz []byte) []byte {
+	h := blake3.Sum256(bz)
+	return h[:]
+}
+
+// SumMany takes at least 1 byteslice along with a variadic
+// number of other byteslices and produces the BLAKE3 sum from
+// hashing them as if they were 1 joined slice.
+func SumMany(data []byte, rest ...[]byte) []byte {
+	h := New()
+	h.Write(data)
+	for _, data := range rest {
+		h.Write(data)
+	}
+	return h.Sum(nil)
+}
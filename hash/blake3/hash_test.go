@@ -0,0 +1,29 @@
+package blake3
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHash(t *testing.T) {
+	testVector := []byte("abc")
+	hasher := New()
+	_, err := hasher.Write(testVector)
+	require.NoError(t, err)
+	bz := hasher.Sum(nil)
+
+	bz2 := Sum(testVector)
+
+	assert.Equal(t, "6437b3ac38465133ffb63b75273a8db548c558465d79db03fd359c6cd5bd9d85", hex.EncodeToString(bz))
+	assert.Equal(t, bz, bz2)
+	assert.Len(t, bz, Size)
+	assert.Equal(t, BlockSize, hasher.BlockSize())
+}
+
+func TestSumMany(t *testing.T) {
+	assert.Equal(t, Sum([]byte("abc")), SumMany([]byte("a"), []byte("b"), nil, []byte("c")))
+	assert.Equal(t, Sum(nil), SumMany(nil))
+}
@@ -0,0 +1,38 @@
+// Package keccak256 implements Keccak-256, the original Keccak submission used
+// by Ethereum, which differs from the standardized SHA3-256 in its padding.
+package keccak256
+
+import (
+	"hash"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	Size      = 32
+	BlockSize = 136
+)
+
+// New returns a new hash.Hash.
+func New() hash.Hash {
+	return sha3.NewLegacyKeccak256()
+}
+
+// Sum returns the Keccak-256 of the bz.
+func Sum(bz []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(bz)
+	return h.Sum(nil)
+}
+
+// SumMany takes at least 1 byteslice along with a variadic
+// number of other byteslices and produces the Keccak-256 sum from
+// hashing them as if they were 1 joined slice.
+func SumMany(data []byte, rest ...[]byte) []byte {
+	h := New()
+	h.Write(data)
+	for _, data := range rest {
+		h.Write(data)
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,29 @@
+package keccak256
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHash(t *testing.T) {
+	testVector := []byte("abc")
+	hasher := New()
+	_, err := hasher.Write(testVector)
+	require.NoError(t, err)
+	bz := hasher.Sum(nil)
+
+	bz2 := Sum(testVector)
+
+	assert.Equal(t, "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45", hex.EncodeToString(bz))
+	assert.Equal(t, bz, bz2)
+	assert.Len(t, bz, Size)
+	assert.Equal(t, BlockSize, hasher.BlockSize())
+}
+
+func TestSumMany(t *testing.T) {
+	assert.Equal(t, Sum([]byte("abc")), SumMany([]byte("a"), []byte("b"), nil, []byte("c")))
+	assert.Equal(t, Sum(nil), SumMany(nil))
+}
@@ -0,0 +1,64 @@
+package merkle
+
+// Builder computes the Merkle root hash of a stream of items without holding
+// them in memory. The result is identical to HashFromByteSlices over the same
+// items. A Builder is not safe for concurrent use.
+type Builder struct {
+	// stack holds the roots of the complete subtrees built so far, from left
+	// to right, with strictly decreasing sizes.
+	stack []subtree
+	count int64
+}
+
+type subtree struct {
+	hash []byte
+	size int64
+}
+
+// NewBuilder returns an empty Builder.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// Add appends an item to the tree.
+func (b *Builder) Add(item []byte) {
+	b.AddLeafHash(leafHash(item))
+}
+
+// AddLeafHash appends an item given by its leaf hash, SHA256(0x00 || item).
+func (b *Builder) AddLeafHash(hash []byte) {
+	b.stack = append(b.stack, subtree{hash: hash, size: 1})
+	b.count++
+	// Merge complete subtrees of equal size, like carries in binary addition.
+	for n := len(b.stack); n >= 2 && b.stack[n-2].size == b.stack[n-1].size; n-- {
+		left, right := b.stack[n-2], b.stack[n-1]
+		b.stack[n-2] = subtree{hash: innerHash(left.hash, right.hash), size: left.size + right.size}
+		b.stack = b.stack[:n-1]
+	}
+}
+
+// Len returns the number of items added so far.
+func (b *Builder) Len() int64 {
+	return b.count
+}
+
+// Hash returns the root hash of the items added so far. More items can be
+// added afterwards.
+func (b *Builder) Hash() []byte {
+	if len(b.stack) == 0 {
+		return emptyHash()
+	}
+	// The split point of a tree is its largest complete left subtree, so the
+	// remaining subtrees are folded from the right.
+	hash := b.stack[len(b.stack)-1].hash
+	for i := len(b.stack) - 2; i >= 0; i-- {
+		hash = innerHash(b.stack[i].hash, hash)
+	}
+	return hash
+}
+
+// Reset empties the Builder.
+func (b *Builder) Reset() {
+	b.stack = b.stack[:0]
+	b.count = 0
+}
@@ -0,0 +1,38 @@
+package merkle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cosmos/crypto/random"
+)
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder()
+	assert.Equal(t, HashFromByteSlices(nil), b.Hash())
+
+	items := make([][]byte, 0, 130)
+	for i := 0; i < 130; i++ {
+		item := random.CRandBytes(i % 40)
+		items = append(items, item)
+		b.Add(item)
+		assert.EqualValues(t, i+1, b.Len())
+		assert.Equal(t, HashFromByteSlices(items), b.Hash(), "Unmatched root hashes for %d items", i+1)
+	}
+
+	b.Reset()
+	assert.EqualValues(t, 0, b.Len())
+	assert.Equal(t, HashFromByteSlices(nil), b.Hash())
+}
+
+func TestBuilder_AddLeafHash(t *testing.T) {
+	items := [][]byte{[]byte("apple"), []byte("watermelon"), []byte("kiwi")}
+	_, proofs := ProofsFromByteSlices(items)
+
+	b := NewBuilder()
+	for _, proof := range proofs {
+		b.AddLeafHash(proof.LeafHash)
+	}
+	assert.Equal(t, HashFromByteSlices(items), b.Hash())
+}
@@ -0,0 +1,12 @@
+// Package merkle computes RFC 6962 Merkle tree hashes over SHA-256, as used by
+// CometBFT for transactions, validator sets and block headers.
+//
+// Leaves are hashed as SHA256(0x00 || leaf) and inner nodes as
+// SHA256(0x01 || left || right), so that a leaf can never be mistaken for an
+// inner node. A tree of n items splits at the largest power of two smaller
+// than n, and the hash of an empty tree is SHA256("").
+//
+// HashFromByteSlices hashes a whole list, ProofsFromByteSlices additionally
+// produces an inclusion proof per item, and Builder computes the same root
+// hash from a stream of items in logarithmic memory.
+package merkle
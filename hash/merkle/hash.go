@@ -0,0 +1,56 @@
+package merkle
+
+import (
+	"math/bits"
+
+	"github.com/cosmos/crypto/hash/sha256"
+)
+
+var (
+	leafPrefix  = []byte{0}
+	innerPrefix = []byte{1}
+)
+
+// emptyHash returns the hash of an empty tree, SHA256("").
+func emptyHash() []byte {
+	return sha256.Sum([]byte{})
+}
+
+// leafHash returns SHA256(0x00 || leaf).
+func leafHash(leaf []byte) []byte {
+	return sha256.SumMany(leafPrefix, leaf)
+}
+
+// innerHash returns SHA256(0x01 || left || right).
+func innerHash(left []byte, right []byte) []byte {
+	return sha256.SumMany(innerPrefix, left, right)
+}
+
+// HashFromByteSlices computes the Merkle root hash of the items.
+func HashFromByteSlices(items [][]byte) []byte {
+	switch len(items) {
+	case 0:
+		return emptyHash()
+	case 1:
+		return leafHash(items[0])
+	default:
+		k := getSplitPoint(int64(len(items)))
+		left := HashFromByteSlices(items[:k])
+		right := HashFromByteSlices(items[k:])
+		return innerHash(left, right)
+	}
+}
+
+// getSplitPoint returns the largest power of 2 less than length.
+func getSplitPoint(length int64) int64 {
+	if length < 1 {
+		panic("Trying to split a tree with size < 1")
+	}
+	uLength := uint(length)
+	bitlen := bits.Len(uLength)
+	k := int64(1 << uint(bitlen-1))
+	if k == length {
+		k >>= 1
+	}
+	return k
+}
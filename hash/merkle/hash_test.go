@@ -0,0 +1,69 @@
+package merkle
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/crypto/random"
+)
+
+func TestHashFromByteSlices(t *testing.T) {
+	testcases := map[string]struct {
+		slices     [][]byte
+		expectHash string // in hex format
+	}{
+		"nil":          {nil, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		"empty":        {[][]byte{}, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		"single":       {[][]byte{{1, 2, 3}}, "054edec1d0211f624fed0cbca9d4f9400b0e491c43742af2c5b0abebf0c990d8"},
+		"single blank": {[][]byte{{}}, "6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d"},
+		"two":          {[][]byte{{1, 2, 3}, {4, 5, 6}}, "82e6cfce00453804379b53962939eaa7906b39904be0813fcadd31b100773c4b"},
+		"many": {
+			[][]byte{{1, 2}, {3, 4}, {5, 6}, {7, 8}, {9, 10}},
+			"f326493eceab4f2d9ffbc78c59432a0a005d6ea98392045c74df5d14a113be18",
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			hash := HashFromByteSlices(tc.slices)
+			assert.Equal(t, tc.expectHash, hex.EncodeToString(hash))
+		})
+	}
+}
+
+func TestGetSplitPoint(t *testing.T) {
+	tests := []struct {
+		length int64
+		want   int64
+	}{
+		{1, 0},
+		{2, 1},
+		{3, 2},
+		{4, 2},
+		{5, 4},
+		{10, 8},
+		{20, 16},
+		{100, 64},
+		{255, 128},
+		{256, 128},
+		{257, 256},
+	}
+	for _, tt := range tests {
+		got := getSplitPoint(tt.length)
+		require.EqualValues(t, tt.want, got, "getSplitPoint(%d) = %v, want %v", tt.length, got, tt.want)
+	}
+	assert.Panics(t, func() { getSplitPoint(0) })
+}
+
+func BenchmarkHashFromByteSlices(b *testing.B) {
+	items := make([][]byte, 1000)
+	for i := range items {
+		items[i] = random.CRandBytes(100)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = HashFromByteSlices(items)
+	}
+}
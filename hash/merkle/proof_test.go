@@ -0,0 +1,92 @@
+package merkle
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/crypto/random"
+)
+
+func TestProofsFromByteSlices(t *testing.T) {
+	for _, total := range []int{1, 2, 3, 7, 8, 9, 100} {
+		items := make([][]byte, total)
+		for i := range items {
+			items[i] = random.CRandBytes(32)
+		}
+
+		rootHash, proofs := ProofsFromByteSlices(items)
+		require.Equal(t, HashFromByteSlices(items), rootHash, "Unmatched root hashes")
+		require.Len(t, proofs, total)
+
+		for i, item := range items {
+			proof := proofs[i]
+			assert.EqualValues(t, i, proof.Index)
+			assert.EqualValues(t, total, proof.Total)
+			require.NoError(t, proof.Verify(rootHash, item))
+			assert.Equal(t, rootHash, proof.ComputeRootHash())
+
+			// Wrong item.
+			err := proof.Verify(rootHash, append(bytes.Clone(item), 0))
+			assert.ErrorIs(t, err, ErrInvalidLeafHash)
+
+			// Wrong root.
+			err = proof.Verify(random.CRandBytes(32), item)
+			assert.ErrorIs(t, err, ErrInvalidRootHash)
+
+			if len(proof.Aunts) == 0 {
+				continue
+			}
+			// Trail too long.
+			proof.Aunts = append(proof.Aunts, random.CRandBytes(32))
+			assert.Error(t, proof.Verify(rootHash, item))
+			proof.Aunts = proof.Aunts[:len(proof.Aunts)-1]
+
+			// Trail too short.
+			proof.Aunts = proof.Aunts[:len(proof.Aunts)-1]
+			assert.Error(t, proof.Verify(rootHash, item))
+			assert.Nil(t, proof.ComputeRootHash())
+		}
+	}
+}
+
+func TestProofsFromByteSlices_Empty(t *testing.T) {
+	rootHash, proofs := ProofsFromByteSlices(nil)
+	assert.Equal(t, emptyHash(), rootHash)
+	assert.Empty(t, proofs)
+}
+
+func TestProofValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		malleateProof func(*Proof)
+		errStr        string
+	}{
+		{"Good", func(sp *Proof) {}, ""},
+		{"Negative Total", func(sp *Proof) { sp.Total = -1 }, "negative Total"},
+		{"Negative Index", func(sp *Proof) { sp.Index = -1 }, "negative Index"},
+		{"Invalid LeafHash", func(sp *Proof) { sp.LeafHash = make([]byte, 10) }, "expected LeafHash size to be 32, got 10"},
+		{"Too many Aunts", func(sp *Proof) { sp.Aunts = make([][]byte, MaxAunts+1) }, "expected no more than 100 aunts, got 101"},
+		{"Invalid Aunt", func(sp *Proof) { sp.Aunts[0] = make([]byte, 10) }, "expected Aunts#0 size to be 32, got 10"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			_, proofs := ProofsFromByteSlices([][]byte{[]byte("apple"), []byte("watermelon"), []byte("kiwi")})
+			tc.malleateProof(proofs[0])
+			err := proofs[0].ValidateBasic()
+			if tc.errStr != "" {
+				assert.ErrorContains(t, err, tc.errStr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerify_NilRoot(t *testing.T) {
+	_, proofs := ProofsFromByteSlices([][]byte{[]byte("apple")})
+	assert.ErrorIs(t, proofs[0].Verify(nil, []byte("apple")), ErrInvalidRootHash)
+}
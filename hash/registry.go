@@ -0,0 +1,127 @@
+// Package hash resolves the hash functions of this module by algorithm name.
+//
+// Every hash package under hash/ is registered under the name returned by
+// Names. Additional algorithms can be added with Register.
+package hash
+
+import (
+	"errors"
+	"fmt"
+	stdhash "hash"
+	"sort"
+
+	"github.com/cosmos/crypto/hash/blake2b"
+	"github.com/cosmos/crypto/hash/blake2s"
+	"github.com/cosmos/crypto/hash/blake3"
+	"github.com/cosmos/crypto/hash/keccak256"
+	"github.com/cosmos/crypto/hash/ripemd160"
+	"github.com/cosmos/crypto/hash/sha256"
+	"github.com/cosmos/crypto/hash/sha3256"
+	"github.com/cosmos/crypto/hash/sha3512"
+	"github.com/cosmos/crypto/hash/sha512"
+	cmtsync "github.com/cosmos/crypto/internal/sync"
+)
+
+// Names of the built-in algorithms.
+const (
+	SHA256     = "sha256"
+	SHA512     = "sha512"
+	SHA3_256   = "sha3-256"
+	SHA3_512   = "sha3-512"
+	Keccak256  = "keccak256"
+	BLAKE2b256 = "blake2b-256"
+	BLAKE2s256 = "blake2s-256"
+	BLAKE3     = "blake3"
+	RIPEMD160  = "ripemd160"
+)
+
+// ErrUnknownAlgorithm is returned when no algorithm is registered under a name.
+var ErrUnknownAlgorithm = errors.New("unknown hash algorithm")
+
+// Algorithm describes a registered hash function.
+type Algorithm struct {
+	Name      string
+	Size      int
+	BlockSize int
+	New       func() stdhash.Hash
+}
+
+// Sum returns the hash of the bz.
+func (a Algorithm) Sum(bz []byte) []byte {
+	h := a.New()
+	h.Write(bz)
+	return h.Sum(nil)
+}
+
+// Hash returns the hash of the input. It lets an Algorithm be used as a
+// crypto-provider components.Hasher; no options are supported.
+func (a Algorithm) Hash(input []byte, _ map[string]any) ([]byte, error) {
+	return a.Sum(input), nil
+}
+
+var registry = struct {
+	cmtsync.RWMutex
+	byName map[string]Algorithm
+}{byName: map[string]Algorithm{}}
+
+func init() {
+	Register(Algorithm{Name: SHA256, Size: sha256.Size, BlockSize: sha256.BlockSize, New: sha256.New})
+	Register(Algorithm{Name: SHA512, Size: sha512.Size, BlockSize: sha512.BlockSize, New: sha512.New})
+	Register(Algorithm{Name: SHA3_256, Size: sha3256.Size, BlockSize: sha3256.BlockSize, New: sha3256.New})
+	Register(Algorithm{Name: SHA3_512, Size: sha3512.Size, BlockSize: sha3512.BlockSize, New: sha3512.New})
+	Register(Algorithm{Name: Keccak256, Size: keccak256.Size, BlockSize: keccak256.BlockSize, New: keccak256.New})
+	Register(Algorithm{Name: BLAKE2b256, Size: blake2b.Size, BlockSize: blake2b.BlockSize, New: blake2b.New})
+	Register(Algorithm{Name: BLAKE2s256, Size: blake2s.Size, BlockSize: blake2s.BlockSize, New: blake2s.New})
+	Register(Algorithm{Name: BLAKE3, Size: blake3.Size, BlockSize: blake3.BlockSize, New: blake3.New})
+	Register(Algorithm{Name: RIPEMD160, Size: ripemd160.Size, BlockSize: ripemd160.BlockSize, New: ripemd160.New})
+}
+
+// Register adds an algorithm to the registry.
+//
+// Should only be called in init() functions, as it panics on error.
+func Register(alg Algorithm) {
+	if alg.Name == "" {
+		panic("hash algorithm name cannot be empty")
+	}
+	if alg.New == nil || alg.Size <= 0 {
+		panic(fmt.Sprintf("hash algorithm %q is incomplete", alg.Name))
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.byName[alg.Name]; ok {
+		panic(fmt.Sprintf("hash algorithm %q is already registered", alg.Name))
+	}
+	registry.byName[alg.Name] = alg
+}
+
+// Get returns the algorithm registered under name.
+func Get(name string) (Algorithm, error) {
+	registry.RLock()
+	defer registry.RUnlock()
+	alg, ok := registry.byName[name]
+	if !ok {
+		return Algorithm{}, fmt.Errorf("%w %q", ErrUnknownAlgorithm, name)
+	}
+	return alg, nil
+}
+
+// Size returns the output size in bytes of the algorithm registered under name.
+func Size(name string) (int, error) {
+	alg, err := Get(name)
+	if err != nil {
+		return 0, err
+	}
+	return alg.Size, nil
+}
+
+// Names returns the sorted names of all registered algorithms.
+func Names() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+	names := make([]string, 0, len(registry.byName))
+	for name := range registry.byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
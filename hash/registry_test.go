@@ -0,0 +1,86 @@
+package hash_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/crypto/hash"
+	"github.com/cosmos/crypto/hash/keccak256"
+	"github.com/cosmos/crypto/hash/sha256"
+)
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+	}{
+		{name: hash.SHA256, size: 32},
+		{name: hash.SHA512, size: 64},
+		{name: hash.SHA3_256, size: 32},
+		{name: hash.SHA3_512, size: 64},
+		{name: hash.Keccak256, size: 32},
+		{name: hash.BLAKE2b256, size: 32},
+		{name: hash.BLAKE2s256, size: 32},
+		{name: hash.BLAKE3, size: 32},
+		{name: hash.RIPEMD160, size: 20},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			alg, err := hash.Get(test.name)
+			require.NoError(t, err)
+			assert.Equal(t, test.name, alg.Name)
+			assert.Equal(t, test.size, alg.Size)
+			assert.Len(t, alg.Sum([]byte("abc")), test.size)
+			assert.Equal(t, alg.BlockSize, alg.New().BlockSize())
+
+			size, err := hash.Size(test.name)
+			require.NoError(t, err)
+			assert.Equal(t, test.size, size)
+		})
+	}
+	for _, test := range tests {
+		assert.Contains(t, hash.Names(), test.name)
+	}
+}
+
+func TestGet_Sums(t *testing.T) {
+	alg, err := hash.Get(hash.SHA256)
+	require.NoError(t, err)
+	assert.Equal(t, sha256.Sum([]byte("abc")), alg.Sum([]byte("abc")))
+
+	alg, err = hash.Get(hash.Keccak256)
+	require.NoError(t, err)
+	out, err := alg.Hash([]byte("abc"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, keccak256.Sum([]byte("abc")), out)
+}
+
+func TestGet_Unknown(t *testing.T) {
+	_, err := hash.Get("md5")
+	assert.ErrorIs(t, err, hash.ErrUnknownAlgorithm)
+	assert.ErrorContains(t, err, `unknown hash algorithm "md5"`)
+	_, err = hash.Size("md5")
+	assert.ErrorIs(t, err, hash.ErrUnknownAlgorithm)
+}
+
+func TestRegister(t *testing.T) {
+	assert.Panics(t, func() {
+		hash.Register(hash.Algorithm{Name: hash.SHA256, Size: sha256.Size, New: sha256.New})
+	})
+	assert.Panics(t, func() {
+		hash.Register(hash.Algorithm{Name: "", Size: sha256.Size, New: sha256.New})
+	})
+	assert.Panics(t, func() {
+		hash.Register(hash.Algorithm{Name: "incomplete"})
+	})
+
+	// The registry is global, so only register once when run with -count.
+	if _, err := hash.Get("sha256-truncated"); err != nil {
+		hash.Register(hash.Algorithm{Name: "sha256-truncated", Size: sha256.TruncatedSize, BlockSize: sha256.BlockSize, New: sha256.NewTruncated})
+	}
+	alg, err := hash.Get("sha256-truncated")
+	require.NoError(t, err)
+	assert.Equal(t, sha256.SumTruncated([]byte("abc")), alg.Sum([]byte("abc")))
+}
@@ -0,0 +1,36 @@
+package ripemd160
+
+import (
+	"hash"
+
+	"golang.org/x/crypto/ripemd160" //nolint: staticcheck // required by Bitcoin-style addresses
+)
+
+const (
+	Size      = ripemd160.Size
+	BlockSize = ripemd160.BlockSize
+)
+
+// New returns a new hash.Hash.
+func New() hash.Hash {
+	return ripemd160.New()
+}
+
+// Sum returns the RIPEMD160 of the bz.
+func Sum(bz []byte) []byte {
+	h := ripemd160.New()
+	h.Write(bz)
+	return h.Sum(nil)
+}
+
+// SumMany takes at least 1 byteslice along with a variadic
+// number of other byteslices and produces the RIPEMD160 sum from
+// hashing them as if they were 1 joined slice.
+func SumMany(data []byte, rest ...[]byte) []byte {
+	h := New()
+	h.Write(data)
+	for _, data := range rest {
+		h.Write(data)
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,29 @@
+package ripemd160
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHash(t *testing.T) {
+	testVector := []byte("abc")
+	hasher := New()
+	_, err := hasher.Write(testVector)
+	require.NoError(t, err)
+	bz := hasher.Sum(nil)
+
+	bz2 := Sum(testVector)
+
+	assert.Equal(t, "8eb208f7e05d987a9b044a8e98c6b087f15a0bfc", hex.EncodeToString(bz))
+	assert.Equal(t, bz, bz2)
+	assert.Len(t, bz, Size)
+	assert.Equal(t, BlockSize, hasher.BlockSize())
+}
+
+func TestSumMany(t *testing.T) {
+	assert.Equal(t, Sum([]byte("abc")), SumMany([]byte("a"), []byte("b"), nil, []byte("c")))
+	assert.Equal(t, Sum(nil), SumMany(nil))
+}
@@ -0,0 +1,61 @@
+package sha256
+
+import (
+	"crypto/sha256"
+	"runtime"
+	"sync"
+)
+
+// minBatchPerWorker is the number of inputs below which spreading a batch
+// over more goroutines costs more than it saves.
+const minBatchPerWorker = 64
+
+// SumBatch returns the SHA256 of every input, in order. Large batches are
+// hashed concurrently on up to GOMAXPROCS goroutines.
+func SumBatch(inputs [][]byte) [][Size]byte {
+	out := make([][Size]byte, len(inputs))
+	forEachChunk(len(inputs), func(start, end int) {
+		for i := start; i < end; i++ {
+			out[i] = sha256.Sum256(inputs[i])
+		}
+	})
+	return out
+}
+
+// SumTruncatedBatch returns the first 20 bytes of the SHA256 of every input,
+// in order, as SumTruncated does for a single input.
+func SumTruncatedBatch(inputs [][]byte) [][TruncatedSize]byte {
+	out := make([][TruncatedSize]byte, len(inputs))
+	forEachChunk(len(inputs), func(start, end int) {
+		for i := start; i < end; i++ {
+			h := sha256.Sum256(inputs[i])
+			out[i] = [TruncatedSize]byte(h[:TruncatedSize])
+		}
+	})
+	return out
+}
+
+// forEachChunk splits [0, n) into contiguous chunks and calls fn on each of
+// them concurrently, returning once all calls are done.
+func forEachChunk(n int, fn func(start, end int)) {
+	workers := runtime.GOMAXPROCS(0)
+	if limit := n / minBatchPerWorker; workers > limit {
+		workers = limit
+	}
+	if workers <= 1 {
+		fn(0, n)
+		return
+	}
+
+	chunk := (n + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += chunk {
+		end := min(start+chunk, n)
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			fn(start, end)
+		}(start, end)
+	}
+	wg.Wait()
+}
@@ -3,6 +3,7 @@ package sha256
 import (
 	"bytes"
 	"crypto/sha256"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -50,3 +51,52 @@ func BenchmarkSHA256Many(b *testing.B) {
 
 	sink = nil
 }
+
+func benchmarkInputs(n int) [][]byte {
+	inputs := make([][]byte, n)
+	for i := range inputs {
+		// Compressed secp256k1 public keys, as hashed for addresses.
+		inputs[i] = bytes.Repeat([]byte{byte(i)}, 33)
+	}
+	return inputs
+}
+
+func BenchmarkSumLoop(b *testing.B) {
+	for _, n := range []int{16, 1 << 10, 1 << 14} {
+		inputs := benchmarkInputs(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				out := make([][Size]byte, len(inputs))
+				for j, input := range inputs {
+					out[j] = sha256.Sum256(input)
+				}
+				sink = out
+			}
+		})
+	}
+}
+
+func BenchmarkSumBatch(b *testing.B) {
+	for _, n := range []int{16, 1 << 10, 1 << 14} {
+		inputs := benchmarkInputs(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sink = SumBatch(inputs)
+			}
+		})
+	}
+}
+
+func BenchmarkSumTruncatedBatch(b *testing.B) {
+	for _, n := range []int{16, 1 << 10, 1 << 14} {
+		inputs := benchmarkInputs(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				sink = SumTruncatedBatch(inputs)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package sha256
 
 import (
 	"crypto/sha256"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -44,3 +45,21 @@ func TestHashTruncated(t *testing.T) {
 	assert.Equal(t, bz, bz2)
 	assert.Equal(t, bz, bz3)
 }
+
+func TestSumBatch(t *testing.T) {
+	for _, n := range []int{0, 1, minBatchPerWorker - 1, 10 * minBatchPerWorker, 10*minBatchPerWorker + 7} {
+		inputs := make([][]byte, n)
+		for i := range inputs {
+			inputs[i] = []byte(strconv.Itoa(i))
+		}
+
+		sums := SumBatch(inputs)
+		truncated := SumTruncatedBatch(inputs)
+		require.Len(t, sums, n)
+		require.Len(t, truncated, n)
+		for i, input := range inputs {
+			assert.Equal(t, Sum(input), sums[i][:])
+			assert.Equal(t, SumTruncated(input), truncated[i][:])
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package sha3256
+
+import (
+	"hash"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	Size      = 32
+	BlockSize = 136
+)
+
+// New returns a new hash.Hash.
+func New() hash.Hash {
+	return sha3.New256()
+}
+
+// Sum returns the SHA3-256 of the bz.
+func Sum(bz []byte) []byte {
+	h := sha3.Sum256(bz)
+	return h[:]
+}
+
+// SumMany takes at least 1 byteslice along with a variadic
+// number of other byteslices and produces the SHA3-256 sum from
+// hashing them as if they were 1 joined slice.
+func SumMany(data []byte, rest ...[]byte) []byte {
+	h := New()
+	h.Write(data)
+	for _, data := range rest {
+		h.Write(data)
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,34 @@
+package sha512
+
+import (
+	"crypto/sha512"
+	"hash"
+)
+
+const (
+	Size      = sha512.Size
+	BlockSize = sha512.BlockSize
+)
+
+// New returns a new hash.Hash.
+func New() hash.Hash {
+	return sha512.New()
+}
+
+// Sum returns the SHA512 of the bz.
+func Sum(bz []byte) []byte {
+	h := sha512.Sum512(bz)
+	return h[:]
+}
+
+// SumMany takes at least 1 byteslice along with a variadic
+// number of other byteslices and produces the SHA512 sum from
+// hashing them as if they were 1 joined slice.
+func SumMany(data []byte, rest ...[]byte) []byte {
+	h := New()
+	h.Write(data)
+	for _, data := range rest {
+		h.Write(data)
+	}
+	return h.Sum(nil)
+}
@@ -8,7 +8,9 @@ import (
 // EvictCallback is used to get a callback when a cache entry is evicted.
 type EvictCallback[K comparable, V any] func(key K, value V)
 
-// LRU implements a non-thread safe fixed size LRU cache.
+// LRU implements a thread safe fixed size LRU cache. evictListLock guards
+// evictList and size, itemsLock guards items; when both are needed,
+// evictListLock is acquired first.
 type LRU[K comparable, V any] struct {
 	itemsLock     sync.RWMutex
 	evictListLock sync.RWMutex
@@ -42,51 +44,43 @@ func NewLRU[K comparable, V any](size int, onEvict EvictCallback[K, V]) (*LRU[K,
 
 // Add adds a value to the cache. Returns true if an eviction occurred.
 func (c *LRU[K, V]) Add(key K, value V) (evicted bool) {
-	// Check for existing item
-	c.itemsLock.RLock()
+	c.lock()
+	var oldest *entry[K, V]
 	if ent, ok := c.items[key]; ok {
-		c.itemsLock.RUnlock()
-
-		c.evictListLock.Lock()
+		// Check for existing item
 		c.evictList.moveToFront(ent)
-		c.evictListLock.Unlock()
 		ent.value = value
-		return false
+	} else {
+		// Add new item
+		c.items[key] = c.evictList.pushFront(key, value)
+		// Verify size not exceeded
+		if c.evictList.length() > c.size {
+			oldest = c.evictList.back()
+			c.removeElement(oldest)
+		}
 	}
-	c.itemsLock.RUnlock()
+	c.unlock()
 
-	// Add new item
-	c.evictListLock.Lock()
-	ent := c.evictList.pushFront(key, value)
-	c.evictListLock.Unlock()
-
-	c.itemsLock.Lock()
-	c.items[key] = ent
-	c.itemsLock.Unlock()
-
-	c.evictListLock.RLock()
-	evict := c.evictList.length() > c.size
-	c.evictListLock.RUnlock()
-
-	// Verify size not exceeded
-	if evict {
-		c.removeOldest()
+	if oldest != nil {
+		c.evicted(oldest)
 	}
-	return evict
+	return oldest != nil
 }
 
 // Get looks up a key's value from the cache.
 func (c *LRU[K, V]) Get(key K) (value V, ok bool) {
 	c.itemsLock.RLock()
-	if ent, ok := c.items[key]; ok {
-		c.itemsLock.RUnlock()
+	ent, ok := c.items[key]
+	if ok {
+		value = ent.value
+	}
+	c.itemsLock.RUnlock()
 
+	if ok {
 		// Make this get function non-blocking for multiple readers.
 		c.getChan <- ent
-		return ent.value, true
 	}
-	c.itemsLock.RUnlock()
-	return
+	return value, ok
 }
 
 // Len returns the number of items in the cache.
@@ -98,37 +92,60 @@ func (c *LRU[K, V]) Len() int {
 
 // Resize changes the cache size.
 func (c *LRU[K, V]) Resize(size int) (evicted int) {
-	diff := c.Len() - size
-	if diff < 0 {
-		diff = 0
-	}
-	for i := 0; i < diff; i++ {
-		c.removeOldest()
+	c.lock()
+	var removed []*entry[K, V]
+	for c.evictList.length() > size {
+		oldest := c.evictList.back()
+		c.removeElement(oldest)
+		removed = append(removed, oldest)
 	}
 	c.size = size
-	return diff
+	c.unlock()
+
+	for _, ent := range removed {
+		c.evicted(ent)
+	}
+	return len(removed)
 }
 
-// removeOldest removes the oldest item from the cache.
-func (c *LRU[K, V]) removeOldest() {
+// Cap returns the maximum number of items the cache can hold.
+func (c *LRU[K, V]) Cap() int {
 	c.evictListLock.RLock()
-	if ent := c.evictList.back(); ent != nil {
-		c.evictListLock.RUnlock()
-		c.removeElement(ent)
-		return
-	}
-	c.evictListLock.RUnlock()
+	defer c.evictListLock.RUnlock()
+	return c.size
 }
 
-// removeElement is used to remove a given list element from the cache.
-func (c *LRU[K, V]) removeElement(e *entry[K, V]) {
+// Purge removes all items from the cache, without calling the eviction
+// callback.
+func (c *LRU[K, V]) Purge() {
+	c.lock()
+	defer c.unlock()
+	c.evictList = newList[K, V]()
+	c.items = make(map[K]*entry[K, V])
+}
+
+// lock acquires both locks, always in the same order, so that the list and
+// the map are modified together.
+func (c *LRU[K, V]) lock() {
 	c.evictListLock.Lock()
-	c.evictList.remove(e)
+	c.itemsLock.Lock()
+}
+
+func (c *LRU[K, V]) unlock() {
+	c.itemsLock.Unlock()
 	c.evictListLock.Unlock()
+}
 
-	c.itemsLock.Lock()
+// removeElement is used to remove a given list element from the cache. Both
+// locks must be held.
+func (c *LRU[K, V]) removeElement(e *entry[K, V]) {
+	c.evictList.remove(e)
 	delete(c.items, e.key)
-	c.itemsLock.Unlock()
+}
+
+// evicted calls the eviction callback for a removed element. It is called
+// without holding the locks.
+func (c *LRU[K, V]) evicted(e *entry[K, V]) {
 	if c.onEvict != nil {
 		c.onEvict(e.key, e.value)
 	}
@@ -0,0 +1,26 @@
+package kdf
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// MaxHKDFLength is the largest output of HKDF with SHA-256.
+const MaxHKDFLength = 255 * sha256.Size
+
+// HKDF derives length bytes from a uniformly random secret with HKDF-SHA256
+// (RFC 5869). The salt may be nil; info binds the output to its purpose.
+// HKDF must not be used on passwords, which need Derive.
+func HKDF(secret, salt, info []byte, length int) ([]byte, error) {
+	if length < 1 || length > MaxHKDFLength {
+		return nil, fmt.Errorf("%w: hkdf length must be between 1 and %d", ErrInvalidParams, MaxHKDFLength)
+	}
+	out := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, info), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,33 @@
+package kdf
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHKDF(t *testing.T) {
+	// RFC 5869, test case 1.
+	secret := bytes.Repeat([]byte{0x0b}, 22)
+	salt, _ := hex.DecodeString("000102030405060708090a0b0c")
+	info, _ := hex.DecodeString("f0f1f2f3f4f5f6f7f8f9")
+	out, err := HKDF(secret, salt, info, 42)
+	require.NoError(t, err)
+	assert.Equal(t, "3cb25f25faacd57a90434f64d0362f2a2d2d0a90cf1a5a4c5db02d56ecc4c5bf34007208d5b887185865", hex.EncodeToString(out))
+
+	// A different info gives an independent key.
+	other, err := HKDF(secret, salt, []byte("other"), 42)
+	require.NoError(t, err)
+	assert.NotEqual(t, out, other)
+
+	_, err = HKDF(secret, nil, nil, 0)
+	assert.ErrorIs(t, err, ErrInvalidParams)
+	_, err = HKDF(secret, nil, nil, MaxHKDFLength+1)
+	assert.ErrorIs(t, err, ErrInvalidParams)
+	out, err = HKDF(secret, nil, nil, MaxHKDFLength)
+	require.NoError(t, err)
+	assert.Len(t, out, MaxHKDFLength)
+}
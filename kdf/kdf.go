@@ -0,0 +1,133 @@
+// Package kdf derives keys from passwords and other secrets.
+//
+// Argon2id, scrypt and PBKDF2-HMAC-SHA256 are available for passwords. Derive
+// returns a KeySize key, suitable for the symmetric ciphers, together with a
+// PHC-style string recording the algorithm, its parameters and the salt:
+//
+//	$argon2id$v=19$m=65536,t=3,p=4$<salt>
+//
+// The string holds no secret and can be stored next to the data the key
+// protects; Rederive recomputes the key from it and the password. Hash
+// instead appends the derived value to the string, for storing password
+// verifiers that are checked with Verify. Both Rederive and Verify reject
+// strings whose parameters exceed DefaultLimits; RederiveWithLimits and
+// VerifyWithLimits take other limits.
+//
+// HKDF expands an already uniformly random secret into one or more keys.
+package kdf
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/crypto/random"
+)
+
+const (
+	// KeySize is the size of the keys returned by Derive and Rederive and of
+	// the hashes produced by Hash.
+	KeySize = 32
+	// SaltSize is the size of the random salts generated by Derive and Hash.
+	SaltSize = 16
+	// MinSaltSize is the smallest salt accepted in an encoded string.
+	MinSaltSize = 8
+)
+
+var (
+	ErrInvalidEncoding      = errors.New("kdf: invalid encoded parameters")
+	ErrUnsupportedAlgorithm = errors.New("kdf: unsupported algorithm")
+	ErrInvalidParams        = errors.New("kdf: invalid parameters")
+)
+
+// Params are the parameters of a password-based key derivation function.
+// They are implemented by Argon2idParams, ScryptParams and PBKDF2Params.
+type Params interface {
+	// Algorithm returns the PHC identifier of the function.
+	Algorithm() string
+	// Validate checks that the parameters are usable.
+	Validate() error
+
+	derive(password, salt []byte, keyLen int) ([]byte, error)
+	encode() string
+}
+
+// Derive derives a KeySize key from the password with a random salt, and
+// returns it with the encoded parameters needed to derive it again.
+func Derive(password []byte, params Params) (key []byte, encoded string, err error) {
+	return DeriveWithSalt(password, random.CRandBytes(SaltSize), params)
+}
+
+// DeriveWithSalt is like Derive with a caller-provided salt, which must be at
+// least MinSaltSize bytes.
+func DeriveWithSalt(password, salt []byte, params Params) (key []byte, encoded string, err error) {
+	if len(salt) < MinSaltSize {
+		return nil, "", fmt.Errorf("%w: salt must be at least %d bytes", ErrInvalidParams, MinSaltSize)
+	}
+	if err := params.Validate(); err != nil {
+		return nil, "", err
+	}
+	key, err = params.derive(password, salt, KeySize)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, encode(params, salt, nil), nil
+}
+
+// Rederive derives the key described by an encoded string from Derive again.
+// It returns ErrLimitsExceeded if the parameters exceed DefaultLimits.
+func Rederive(password []byte, encoded string) ([]byte, error) {
+	return RederiveWithLimits(password, encoded, DefaultLimits)
+}
+
+// RederiveWithLimits is like Rederive with the given limits.
+func RederiveWithLimits(password []byte, encoded string, limits Limits) ([]byte, error) {
+	params, salt, _, err := Parse(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if err := limits.Check(params); err != nil {
+		return nil, err
+	}
+	return params.derive(password, salt, KeySize)
+}
+
+// Hash derives a KeySize hash of the password with a random salt and returns
+// the encoded parameters followed by the hash, to be checked with Verify.
+func Hash(password []byte, params Params) (string, error) {
+	if err := params.Validate(); err != nil {
+		return "", err
+	}
+	salt := random.CRandBytes(SaltSize)
+	hash, err := params.derive(password, salt, KeySize)
+	if err != nil {
+		return "", err
+	}
+	return encode(params, salt, hash), nil
+}
+
+// Verify reports whether the password matches an encoded hash from Hash.
+// An error is only returned if the encoded hash is malformed or its
+// parameters exceed DefaultLimits.
+func Verify(password []byte, encoded string) (bool, error) {
+	return VerifyWithLimits(password, encoded, DefaultLimits)
+}
+
+// VerifyWithLimits is like Verify with the given limits.
+func VerifyWithLimits(password []byte, encoded string, limits Limits) (bool, error) {
+	params, salt, hash, err := Parse(encoded)
+	if err != nil {
+		return false, err
+	}
+	if err := limits.Check(params); err != nil {
+		return false, err
+	}
+	if len(hash) == 0 {
+		return false, fmt.Errorf("%w: missing hash", ErrInvalidEncoding)
+	}
+	computed, err := params.derive(password, salt, len(hash))
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare(hash, computed) == 1, nil
+}
@@ -0,0 +1,240 @@
+package kdf
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Cheap parameters so that the tests run quickly.
+var testParams = []Params{
+	Argon2idParams{Memory: 64, Time: 1, Threads: 1},
+	ScryptParams{LogN: 4, R: 8, P: 1},
+	PBKDF2Params{Iterations: 10},
+}
+
+func TestDeriveVectors(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   Params
+		password string
+		salt     string
+		want     string
+	}{
+		{
+			// RFC 7914, section 11.
+			name:     "scrypt",
+			params:   ScryptParams{LogN: 10, R: 8, P: 16},
+			password: "password",
+			salt:     "NaCl",
+			want:     "fdbabe1c9d3472007856e7190d01e9fe7c6ad7cbc8237830e77376634b3731622eaf30d92e22a3886ff109279d9830dac727afb94a83ee6d8360cbdfa2cc0640",
+		},
+		{
+			// RFC 7914, section 11.
+			name:     "pbkdf2",
+			params:   PBKDF2Params{Iterations: 1},
+			password: "passwd",
+			salt:     "salt",
+			want:     "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.params.derive([]byte(test.password), []byte(test.salt), len(test.want)/2)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, hex.EncodeToString(got))
+		})
+	}
+}
+
+func TestVerify_Argon2id(t *testing.T) {
+	// Argon2id test vector of golang.org/x/crypto/argon2, as a PHC string.
+	encoded := "$argon2id$v=19$m=64,t=1,p=1$c29tZXNhbHQ$ZVrRXqxlLcWfcXCnMyv0m4Rpvh/bnCi7"
+	ok, err := Verify([]byte("password"), encoded)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestDeriveRederive(t *testing.T) {
+	for _, params := range testParams {
+		t.Run(params.Algorithm(), func(t *testing.T) {
+			key, encoded, err := Derive([]byte("password"), params)
+			require.NoError(t, err)
+			assert.Len(t, key, KeySize)
+			assert.True(t, strings.HasPrefix(encoded, "$"+params.Algorithm()+"$"))
+			// Only the parameters and the salt are encoded.
+			assert.Len(t, strings.Split(encoded, "$"), strings.Count(encode(params, []byte("salt"), nil), "$")+1)
+
+			key2, err := Rederive([]byte("password"), encoded)
+			require.NoError(t, err)
+			assert.Equal(t, key, key2)
+
+			other, err := Rederive([]byte("passw0rd"), encoded)
+			require.NoError(t, err)
+			assert.NotEqual(t, key, other)
+
+			parsed, _, hash, err := Parse(encoded)
+			require.NoError(t, err)
+			assert.Equal(t, params, parsed)
+			assert.Nil(t, hash)
+
+			_, err = Verify([]byte("password"), encoded)
+			assert.ErrorIs(t, err, ErrInvalidEncoding)
+		})
+	}
+}
+
+func TestHashVerify(t *testing.T) {
+	for _, params := range testParams {
+		t.Run(params.Algorithm(), func(t *testing.T) {
+			encoded, err := Hash([]byte("password"), params)
+			require.NoError(t, err)
+
+			ok, err := Verify([]byte("password"), encoded)
+			require.NoError(t, err)
+			assert.True(t, ok)
+
+			ok, err = Verify([]byte("passw0rd"), encoded)
+			require.NoError(t, err)
+			assert.False(t, ok)
+
+			// Two hashes of the same password use different salts.
+			encoded2, err := Hash([]byte("password"), params)
+			require.NoError(t, err)
+			assert.NotEqual(t, encoded, encoded2)
+		})
+	}
+}
+
+func TestDeriveWithSalt(t *testing.T) {
+	params := testParams[0]
+	key, encoded, err := DeriveWithSalt([]byte("password"), []byte("somesalt"), params)
+	require.NoError(t, err)
+	assert.Equal(t, "$argon2id$v=19$m=64,t=1,p=1$c29tZXNhbHQ", encoded)
+	key2, _, err := DeriveWithSalt([]byte("password"), []byte("somesalt"), params)
+	require.NoError(t, err)
+	assert.Equal(t, key, key2)
+
+	_, _, err = DeriveWithSalt([]byte("password"), []byte("short"), params)
+	assert.ErrorIs(t, err, ErrInvalidParams)
+}
+
+func TestInvalidParams(t *testing.T) {
+	for _, params := range []Params{
+		Argon2idParams{Memory: 64, Time: 0, Threads: 1},
+		Argon2idParams{Memory: 64, Time: 1, Threads: 0},
+		Argon2idParams{Memory: 7, Time: 1, Threads: 1},
+		Argon2idParams{Memory: maxArgon2Memory + 1, Time: 1, Threads: 1},
+		Argon2idParams{Memory: 64, Time: maxArgon2Time + 1, Threads: 1},
+		ScryptParams{LogN: 0, R: 8, P: 1},
+		ScryptParams{LogN: 31, R: 8, P: 1},
+		ScryptParams{LogN: 4, R: 0, P: 1},
+		ScryptParams{LogN: 4, R: 1 << 15, P: 1 << 15},
+		ScryptParams{LogN: 4, R: 8, P: maxScryptP + 1},
+		ScryptParams{LogN: 16, R: 1, P: 1},
+		ScryptParams{LogN: 30, R: 8, P: 1},
+		ScryptParams{LogN: 30, R: 1 << 29, P: 1},
+		ScryptParams{LogN: 1, R: 1 << 62, P: 1},
+		PBKDF2Params{Iterations: 0},
+		PBKDF2Params{Iterations: maxPBKDF2Iterations + 1},
+	} {
+		_, _, err := Derive([]byte("password"), params)
+		assert.ErrorIs(t, err, ErrInvalidParams, "%#v", params)
+		_, err = Hash([]byte("password"), params)
+		assert.ErrorIs(t, err, ErrInvalidParams, "%#v", params)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	for _, params := range append([]Params{DefaultArgon2idParams, DefaultScryptParams, DefaultPBKDF2Params}, testParams...) {
+		assert.NoError(t, DefaultLimits.Check(params), "%#v", params)
+	}
+	// EIP-2335 keystores use scrypt with n=2^18, r=8, p=1.
+	assert.NoError(t, DefaultLimits.Check(ScryptParams{LogN: 18, R: 8, P: 1}))
+
+	for _, encoded := range []string{
+		"$argon2id$v=19$m=4194304,t=1,p=1$c29tZXNhbHQ",
+		"$argon2id$v=19$m=64,t=64,p=1$c29tZXNhbHQ",
+		"$argon2id$v=19$m=4096,t=1,p=255$c29tZXNhbHQ",
+		"$scrypt$ln=19,r=8,p=1$c29tZXNhbHQ",
+		"$scrypt$ln=4,r=8,p=16$c29tZXNhbHQ",
+		"$pbkdf2-sha256$i=10000000$c29tZXNhbHQ",
+	} {
+		_, err := Rederive([]byte("password"), encoded)
+		assert.ErrorIs(t, err, ErrLimitsExceeded, encoded)
+		_, err = Verify([]byte("password"), encoded+"$c29tZWhhc2hzb21laGFzaA")
+		assert.ErrorIs(t, err, ErrLimitsExceeded, encoded)
+	}
+
+	encoded, err := Hash([]byte("password"), testParams[2])
+	require.NoError(t, err)
+	limits := DefaultLimits
+	limits.MaxPBKDF2Iterations = 5
+	_, err = VerifyWithLimits([]byte("password"), encoded, limits)
+	assert.ErrorIs(t, err, ErrLimitsExceeded)
+	_, err = RederiveWithLimits([]byte("password"), encoded, limits)
+	assert.ErrorIs(t, err, ErrLimitsExceeded)
+	assert.ErrorIs(t, limits.Check(PBKDF2Params{Iterations: 0}), ErrInvalidParams)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		encoded string
+		err     error
+	}{
+		{"", ErrInvalidEncoding},
+		{"argon2id$v=19$m=64,t=1,p=1$c29tZXNhbHQ", ErrInvalidEncoding},
+		{"$bcrypt$10$c29tZXNhbHQ", ErrUnsupportedAlgorithm},
+		{"$argon2i$v=19$m=64,t=1,p=1$c29tZXNhbHQ", ErrUnsupportedAlgorithm},
+		{"$argon2id$v=16$m=64,t=1,p=1$c29tZXNhbHQ", ErrInvalidEncoding},
+		{"$argon2id$v=19$t=1,m=64,p=1$c29tZXNhbHQ", ErrInvalidEncoding},
+		{"$argon2id$v=19$m=64,t=1$c29tZXNhbHQ", ErrInvalidEncoding},
+		{"$argon2id$v=19$m=64,t=1,p=256$c29tZXNhbHQ", ErrInvalidEncoding},
+		{"$argon2id$v=19$m=64,t=0,p=1$c29tZXNhbHQ", ErrInvalidParams},
+		{"$argon2id$v=19$m=64,t=1,p=1", ErrInvalidEncoding},
+		{"$argon2id$v=19$m=64,t=1,p=1$c29tZQ", ErrInvalidEncoding},
+		{"$argon2id$v=19$m=64,t=1,p=1$c29tZXNhbHQ=", ErrInvalidEncoding},
+		{"$argon2id$v=19$m=64,t=1,p=1$c29tZXNhbHQ$c2hvcnQ", ErrInvalidEncoding},
+		{"$argon2id$v=19$m=64,t=1,p=1$c29tZXNhbHQ$$", ErrInvalidEncoding},
+		{"$scrypt$ln=-1,r=8,p=1$c29tZXNhbHQ", ErrInvalidEncoding},
+		{"$scrypt$ln=40,r=8,p=1$c29tZXNhbHQ", ErrInvalidParams},
+		{"$scrypt$ln=30,r=536870912,p=1$c29tZXNhbHQ", ErrInvalidParams},
+		{"$scrypt$ln=30,r=8,p=1$c29tZXNhbHQ", ErrInvalidParams},
+		{"$scrypt$ln=30,r=536870912,p=1$c29tZXNhbHQ$c29tZWhhc2g", ErrInvalidParams},
+		{"$argon2id$v=19$m=64,t=4294967295,p=1$c29tZXNhbHQ", ErrInvalidParams},
+		{"$pbkdf2-sha256$i=0$c29tZXNhbHQ", ErrInvalidParams},
+		{"$pbkdf2-sha256$i=2147483647$c29tZXNhbHQ", ErrInvalidParams},
+		{"$pbkdf2-sha256$c=10$c29tZXNhbHQ", ErrInvalidEncoding},
+	}
+	for _, test := range tests {
+		_, _, _, err := Parse(test.encoded)
+		assert.ErrorIs(t, err, test.err, test.encoded)
+		_, err = Rederive([]byte("password"), test.encoded)
+		assert.Error(t, err, test.encoded)
+		_, err = Verify([]byte("password"), test.encoded)
+		assert.Error(t, err, test.encoded)
+	}
+}
+
+func TestEncodeParseParams(t *testing.T) {
+	for _, params := range append(testParams, DefaultArgon2idParams, DefaultScryptParams, DefaultPBKDF2Params) {
+		encoded := EncodeParams(params)
+		parsed, err := ParseParams(encoded)
+		require.NoError(t, err, encoded)
+		require.Equal(t, params, parsed)
+	}
+	for _, params := range testParams {
+		_, derived, err := Derive([]byte("password"), params)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(derived, EncodeParams(params)+"$"), derived)
+	}
+	require.Equal(t, "$argon2id$v=19$m=65536,t=3,p=4", EncodeParams(DefaultArgon2idParams))
+
+	for _, encoded := range []string{"", "$argon2id$v=19", "$argon2id$v=19$m=64,t=1,p=1$c29tZXNhbHQ", "$scrypt$ln=4,r=8,p=1$"} {
+		_, err := ParseParams(encoded)
+		assert.ErrorIs(t, err, ErrInvalidEncoding, encoded)
+	}
+}
@@ -0,0 +1,51 @@
+package kdf
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrLimitsExceeded is returned for valid parameters exceeding Limits.
+var ErrLimitsExceeded = errors.New("kdf: parameters exceed the limits")
+
+// Limits bound the cost of parameters read from untrusted sources, such as
+// stored encoded strings or key files, well below what Validate accepts.
+type Limits struct {
+	MaxMemory           uint64 // Memory of Argon2id and scrypt, in bytes.
+	MaxArgon2Time       uint32
+	MaxArgon2Threads    uint8
+	MaxScryptP          int
+	MaxPBKDF2Iterations int
+}
+
+// DefaultLimits are applied by Rederive and Verify. They admit the default
+// parameters and those of other common implementations, and cap the memory
+// at 256 MiB and the work at a few seconds.
+var DefaultLimits = Limits{
+	MaxMemory:           256 << 20,
+	MaxArgon2Time:       16,
+	MaxArgon2Threads:    16,
+	MaxScryptP:          4,
+	MaxPBKDF2Iterations: 2_000_000,
+}
+
+// Check returns an error matching ErrInvalidParams if the parameters are
+// invalid, and ErrLimitsExceeded if they exceed the limits.
+func (l Limits) Check(params Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	var ok bool
+	switch p := params.(type) {
+	case Argon2idParams:
+		ok = uint64(p.Memory)*1024 <= l.MaxMemory && p.Time <= l.MaxArgon2Time && p.Threads <= l.MaxArgon2Threads
+	case ScryptParams:
+		ok = p.P <= l.MaxScryptP && uint64(p.R) <= l.MaxMemory/128>>p.LogN
+	case PBKDF2Params:
+		ok = p.Iterations <= l.MaxPBKDF2Iterations
+	}
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrLimitsExceeded, EncodeParams(params))
+	}
+	return nil
+}
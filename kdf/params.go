@@ -0,0 +1,133 @@
+package kdf
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// PHC identifiers of the supported algorithms.
+const (
+	Argon2id = "argon2id"
+	Scrypt   = "scrypt"
+	PBKDF2   = "pbkdf2-sha256"
+)
+
+var (
+	// DefaultArgon2idParams are the second recommended option of RFC 9106.
+	DefaultArgon2idParams = Argon2idParams{Memory: 64 * 1024, Time: 3, Threads: 4}
+	// DefaultScryptParams follow the OWASP recommendation.
+	DefaultScryptParams = ScryptParams{LogN: 17, R: 8, P: 1}
+	// DefaultPBKDF2Params follow the OWASP recommendation for HMAC-SHA256.
+	DefaultPBKDF2Params = PBKDF2Params{Iterations: 600_000}
+)
+
+// Validate bounds the cost of the parameters so that even callers raising
+// the Limits cannot be made to exhaust the memory or hold the CPU for hours:
+// both Argon2id and scrypt are limited to 4 GiB, the number of Argon2id passes
+// and of PBKDF2 iterations to about twenty times the defaults, and scrypt
+// parallelism to 16.
+const (
+	maxArgon2Memory     = 4 * 1024 * 1024 // In KiB.
+	maxArgon2Time       = 64
+	maxScryptMemory     = 4 << 30 // In bytes.
+	maxScryptP          = 16
+	maxPBKDF2Iterations = 10_000_000
+)
+
+// Argon2idParams are the parameters of Argon2id.
+type Argon2idParams struct {
+	Memory  uint32 // Memory in KiB.
+	Time    uint32 // Number of passes.
+	Threads uint8  // Degree of parallelism.
+}
+
+var _ Params = Argon2idParams{}
+
+func (Argon2idParams) Algorithm() string { return Argon2id }
+
+func (p Argon2idParams) Validate() error {
+	switch {
+	case p.Time < 1 || p.Time > maxArgon2Time:
+		return fmt.Errorf("%w: argon2id time must be between 1 and %d", ErrInvalidParams, maxArgon2Time)
+	case p.Threads < 1:
+		return fmt.Errorf("%w: argon2id threads must be at least 1", ErrInvalidParams)
+	case p.Memory < 8*uint32(p.Threads):
+		return fmt.Errorf("%w: argon2id memory must be at least 8 KiB per thread", ErrInvalidParams)
+	case p.Memory > maxArgon2Memory:
+		return fmt.Errorf("%w: argon2id memory must be at most %d KiB", ErrInvalidParams, maxArgon2Memory)
+	}
+	return nil
+}
+
+func (p Argon2idParams) derive(password, salt []byte, keyLen int) ([]byte, error) {
+	return argon2.IDKey(password, salt, p.Time, p.Memory, p.Threads, uint32(keyLen)), nil
+}
+
+func (p Argon2idParams) encode() string {
+	return fmt.Sprintf("v=%d$m=%d,t=%d,p=%d", argon2.Version, p.Memory, p.Time, p.Threads)
+}
+
+// ScryptParams are the parameters of scrypt. The cost N is 2^LogN.
+type ScryptParams struct {
+	LogN uint8
+	R    int
+	P    int
+}
+
+var _ Params = ScryptParams{}
+
+func (ScryptParams) Algorithm() string { return Scrypt }
+
+func (p ScryptParams) Validate() error {
+	switch {
+	case p.LogN < 1 || p.LogN > 30:
+		return fmt.Errorf("%w: scrypt ln must be between 1 and 30", ErrInvalidParams)
+	case p.R < 1 || p.P < 1 || p.P > maxScryptP:
+		return fmt.Errorf("%w: scrypt r must be at least 1 and p between 1 and %d", ErrInvalidParams, maxScryptP)
+	case int(p.LogN) >= 16*p.R:
+		return fmt.Errorf("%w: scrypt ln must be less than 16*r", ErrInvalidParams)
+	case uint64(p.R) > maxScryptMemory/128>>p.LogN:
+		return fmt.Errorf("%w: scrypt memory 128*r*2^ln must be at most %d bytes", ErrInvalidParams, uint64(maxScryptMemory))
+	}
+	return nil
+}
+
+func (p ScryptParams) derive(password, salt []byte, keyLen int) ([]byte, error) {
+	key, err := scrypt.Key(password, salt, 1<<p.LogN, p.R, p.P, keyLen)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidParams, err)
+	}
+	return key, nil
+}
+
+func (p ScryptParams) encode() string {
+	return fmt.Sprintf("ln=%d,r=%d,p=%d", p.LogN, p.R, p.P)
+}
+
+// PBKDF2Params are the parameters of PBKDF2 with HMAC-SHA256.
+type PBKDF2Params struct {
+	Iterations int
+}
+
+var _ Params = PBKDF2Params{}
+
+func (PBKDF2Params) Algorithm() string { return PBKDF2 }
+
+func (p PBKDF2Params) Validate() error {
+	if p.Iterations < 1 || p.Iterations > maxPBKDF2Iterations {
+		return fmt.Errorf("%w: pbkdf2 iterations must be between 1 and %d", ErrInvalidParams, maxPBKDF2Iterations)
+	}
+	return nil
+}
+
+func (p PBKDF2Params) derive(password, salt []byte, keyLen int) ([]byte, error) {
+	return pbkdf2.Key(password, salt, p.Iterations, keyLen, sha256.New), nil
+}
+
+func (p PBKDF2Params) encode() string {
+	return fmt.Sprintf("i=%d", p.Iterations)
+}
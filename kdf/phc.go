@@ -0,0 +1,159 @@
+package kdf
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// b64 is the unpadded standard base64 of the PHC string format.
+var b64 = base64.RawStdEncoding.Strict()
+
+// encode returns $<algorithm>$<params>$<salt>[$<hash>].
+func encode(params Params, salt, hash []byte) string {
+	var b strings.Builder
+	b.WriteString(EncodeParams(params))
+	b.WriteString("$" + b64.EncodeToString(salt))
+	if len(hash) > 0 {
+		b.WriteString("$" + b64.EncodeToString(hash))
+	}
+	return b.String()
+}
+
+// EncodeParams returns the parameters in the PHC string format without salt,
+// $<algorithm>$<params>, for storing them apart from the salt.
+func EncodeParams(params Params) string {
+	return "$" + params.Algorithm() + "$" + params.encode()
+}
+
+// ParseParams decodes a string produced by EncodeParams. The parameters are
+// validated.
+func ParseParams(encoded string) (Params, error) {
+	fields, err := splitFields(encoded)
+	if err != nil {
+		return nil, err
+	}
+	params, rest, err := parseParams(fields)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, ErrInvalidEncoding
+	}
+	return params, nil
+}
+
+// Parse decodes a string produced by Derive or Hash into its parameters, salt
+// and, for Hash, the hash. The parameters are validated.
+func Parse(encoded string) (params Params, salt, hash []byte, err error) {
+	fields, err := splitFields(encoded)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	params, fields, err = parseParams(fields)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(fields) < 1 || len(fields) > 2 {
+		return nil, nil, nil, ErrInvalidEncoding
+	}
+	salt, err = b64.DecodeString(fields[0])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: salt: %w", ErrInvalidEncoding, err)
+	}
+	if len(salt) < MinSaltSize {
+		return nil, nil, nil, fmt.Errorf("%w: salt must be at least %d bytes", ErrInvalidEncoding, MinSaltSize)
+	}
+	if len(fields) == 2 {
+		hash, err = b64.DecodeString(fields[1])
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("%w: hash: %w", ErrInvalidEncoding, err)
+		}
+		if len(hash) < KeySize/2 {
+			return nil, nil, nil, fmt.Errorf("%w: hash must be at least %d bytes", ErrInvalidEncoding, KeySize/2)
+		}
+	}
+	return params, salt, hash, nil
+}
+
+// splitFields splits an encoded string into its $-separated fields, which
+// start with the algorithm and its parameters.
+func splitFields(encoded string) ([]string, error) {
+	fields := strings.Split(encoded, "$")
+	if len(fields) < 3 || fields[0] != "" {
+		return nil, ErrInvalidEncoding
+	}
+	return fields[1:], nil
+}
+
+// parseParams decodes and validates the parameters at the start of fields,
+// and returns the fields that follow them.
+func parseParams(fields []string) (params Params, rest []string, err error) {
+	switch fields[0] {
+	case Argon2id:
+		if fields[1] != "v="+strconv.Itoa(argon2.Version) {
+			return nil, nil, fmt.Errorf("%w: unsupported argon2id version %q", ErrInvalidEncoding, fields[1])
+		}
+		fields = fields[1:]
+		if len(fields) < 2 {
+			return nil, nil, ErrInvalidEncoding
+		}
+		values, err := parseValues(fields[1], "m", "t", "p")
+		if err != nil {
+			return nil, nil, err
+		}
+		if values[0] > 1<<32-1 || values[1] > 1<<32-1 || values[2] > 1<<8-1 {
+			return nil, nil, fmt.Errorf("%w: argon2id parameter out of range", ErrInvalidEncoding)
+		}
+		params = Argon2idParams{Memory: uint32(values[0]), Time: uint32(values[1]), Threads: uint8(values[2])}
+	case Scrypt:
+		values, err := parseValues(fields[1], "ln", "r", "p")
+		if err != nil {
+			return nil, nil, err
+		}
+		if values[0] > 1<<8-1 || values[1] > 1<<31-1 || values[2] > 1<<31-1 {
+			return nil, nil, fmt.Errorf("%w: scrypt parameter out of range", ErrInvalidEncoding)
+		}
+		params = ScryptParams{LogN: uint8(values[0]), R: int(values[1]), P: int(values[2])}
+	case PBKDF2:
+		values, err := parseValues(fields[1], "i")
+		if err != nil {
+			return nil, nil, err
+		}
+		if values[0] > 1<<31-1 {
+			return nil, nil, fmt.Errorf("%w: pbkdf2 parameter out of range", ErrInvalidEncoding)
+		}
+		params = PBKDF2Params{Iterations: int(values[0])}
+	default:
+		return nil, nil, fmt.Errorf("%w %q", ErrUnsupportedAlgorithm, fields[0])
+	}
+	if err := params.Validate(); err != nil {
+		return nil, nil, err
+	}
+	return params, fields[2:], nil
+}
+
+// parseValues parses a list of k=v pairs with exactly the given keys, in
+// order, into their decimal values.
+func parseValues(s string, keys ...string) ([]uint64, error) {
+	pairs := strings.Split(s, ",")
+	if len(pairs) != len(keys) {
+		return nil, fmt.Errorf("%w: expected parameters %s", ErrInvalidEncoding, strings.Join(keys, ","))
+	}
+	values := make([]uint64, len(keys))
+	for i, pair := range pairs {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok || k != keys[i] {
+			return nil, fmt.Errorf("%w: expected parameters %s", ErrInvalidEncoding, strings.Join(keys, ","))
+		}
+		value, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: parameter %s: %w", ErrInvalidEncoding, k, err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
@@ -0,0 +1,104 @@
+// Package aes256gcm implements symmetric.Symmetric with AES-256 in GCM mode
+// and random 96-bit nonces.
+//
+// Random nonces of this size are only safe for about 2^32 messages under the
+// same key; prefer XChaCha20-Poly1305 when a key encrypts many messages.
+package aes256gcm
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+
+	"github.com/cosmos/crypto/random"
+	"github.com/cosmos/crypto/symmetric"
+)
+
+const (
+	// KeySize is the size of the secret, in bytes.
+	KeySize = 32
+	// NonceSize is the size of the nonce, in bytes.
+	NonceSize = 12
+	// TagSize is the size of the authentication tag, in bytes.
+	TagSize = 16
+)
+
+// The errors match the shared errors of package symmetric with errors.Is.
+var (
+	ErrInvalidKeyLen = symmetric.NewError(symmetric.ErrInvalidKeyLen, "aes256gcm: bad key length")
+	ErrOpen          = symmetric.NewError(symmetric.ErrDecryption, "aes256gcm: message authentication failed")
+)
+
+func init() {
+	symmetric.Register(symmetric.AES256GCM, NewSymmetric())
+}
+
+// aesGCMSymmetric implements symmetric.Symmetric.
+type aesGCMSymmetric struct{}
+
+var _ symmetric.SymmetricE = aesGCMSymmetric{}
+
+// NewSymmetric returns a symmetric.Symmetric producing AES-256-GCM envelopes.
+func NewSymmetric() symmetric.SymmetricE {
+	return aesGCMSymmetric{}
+}
+
+// Keygen returns a random KeySize secret.
+func (aesGCMSymmetric) Keygen() []byte {
+	return random.CRandBytes(KeySize)
+}
+
+// Encrypt seals the plaintext into an envelope. secret must be KeySize bytes
+// long.
+func (s aesGCMSymmetric) Encrypt(plaintext []byte, secret []byte) (ciphertext []byte) {
+	ciphertext, err := s.EncryptE(plaintext, secret)
+	if err != nil {
+		panic(fmt.Sprintf("Secret must be %d bytes long, got len %v", KeySize, len(secret)))
+	}
+	return ciphertext
+}
+
+// EncryptE is like Encrypt, but returns ErrInvalidKeyLen instead of panicking
+// if secret is not KeySize bytes long.
+func (aesGCMSymmetric) EncryptE(plaintext []byte, secret []byte) (ciphertext []byte, err error) {
+	aead, err := newAEAD(secret)
+	if err != nil {
+		return nil, err
+	}
+	return symmetric.SealAEAD(symmetric.AES256GCM, aead, plaintext), nil
+}
+
+// Decrypt opens an envelope produced by Encrypt. It returns ErrInvalidKeyLen
+// if secret is not KeySize bytes long.
+func (aesGCMSymmetric) Decrypt(ciphertext []byte, secret []byte) (plaintext []byte, err error) {
+	aead, err := newAEAD(secret)
+	if err != nil {
+		return nil, err
+	}
+	body, err := symmetric.OpenHeader(symmetric.AES256GCM, ciphertext, NonceSize+TagSize)
+	if err != nil {
+		return nil, err
+	}
+	// crypto/cipher reports authentication failures with an unexported error.
+	plaintext, err = aead.Open(nil, body[:NonceSize], body[NonceSize:], ciphertext[:symmetric.HeaderSize])
+	if err != nil {
+		return nil, ErrOpen
+	}
+	return plaintext, nil
+}
+
+func newAEAD(secret []byte) (cipher.AEAD, error) {
+	if len(secret) != KeySize {
+		return nil, ErrInvalidKeyLen
+	}
+	// Neither call can fail with a 32 byte key and the default sizes.
+	block, err := aes.NewCipher(secret)
+	if err != nil {
+		panic(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+	return aead, nil
+}
@@ -0,0 +1,45 @@
+package aes256gcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/crypto/symmetric"
+)
+
+func TestSymmetricRoundTrip(t *testing.T) {
+	s := NewSymmetric()
+	secret := s.Keygen()
+	require.Len(t, secret, KeySize)
+
+	plaintext := []byte("sometext")
+	ciphertext := s.Encrypt(plaintext, secret)
+	require.Equal(t, symmetric.Header(symmetric.AES256GCM), ciphertext[:symmetric.HeaderSize])
+	require.Len(t, ciphertext, symmetric.HeaderSize+NonceSize+TagSize+len(plaintext))
+
+	plaintext2, err := s.Decrypt(ciphertext, secret)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, plaintext2)
+
+	_, err = s.Decrypt(ciphertext, s.Keygen())
+	require.Error(t, err)
+}
+
+func TestSymmetricBadSecret(t *testing.T) {
+	s := NewSymmetric()
+	require.Panics(t, func() { s.Encrypt([]byte("sometext"), make([]byte, 16)) })
+	_, err := s.EncryptE([]byte("sometext"), make([]byte, 16))
+	require.ErrorIs(t, err, ErrInvalidKeyLen)
+	require.ErrorIs(t, err, symmetric.ErrInvalidKeyLen)
+
+	_, err = s.Decrypt(nil, make([]byte, 16))
+	require.ErrorIs(t, err, ErrInvalidKeyLen)
+	require.ErrorIs(t, err, symmetric.ErrInvalidKeyLen)
+
+	ciphertext, err := s.EncryptE([]byte("sometext"), s.Keygen())
+	require.NoError(t, err)
+	_, err = s.Decrypt(ciphertext, s.Keygen())
+	require.ErrorIs(t, err, ErrOpen)
+	require.ErrorIs(t, err, symmetric.ErrDecryption)
+}
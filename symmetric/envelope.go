@@ -0,0 +1,102 @@
+package symmetric
+
+import (
+	"crypto/cipher"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/crypto/random"
+)
+
+// EnvelopeVersion is the version of the ciphertext envelope produced by the
+// Symmetric implementations. An envelope is
+//
+//	version (1 byte) || algorithm (1 byte) || nonce || sealed plaintext
+//
+// where the nonce size depends on the algorithm. For AEAD ciphers the two
+// header bytes are authenticated as additional data.
+const EnvelopeVersion byte = 1
+
+// HeaderSize is the size of the envelope header preceding the nonce.
+const HeaderSize = 2
+
+// Algorithm identifies the cipher of an envelope.
+type Algorithm byte
+
+const (
+	XSalsa20Poly1305  Algorithm = 1
+	XChaCha20Poly1305 Algorithm = 2
+	AES256GCM         Algorithm = 3
+)
+
+func (a Algorithm) String() string {
+	switch a {
+	case XSalsa20Poly1305:
+		return "xsalsa20-poly1305"
+	case XChaCha20Poly1305:
+		return "xchacha20-poly1305"
+	case AES256GCM:
+		return "aes-256-gcm"
+	default:
+		return fmt.Sprintf("Algorithm(%d)", byte(a))
+	}
+}
+
+var (
+	ErrEnvelopeTooShort   = NewError(ErrInvalidCiphertextLen, "symmetric: ciphertext is too short")
+	ErrUnsupportedVersion = errors.New("symmetric: unsupported envelope version")
+	ErrAlgorithmMismatch  = errors.New("symmetric: envelope algorithm mismatch")
+)
+
+// Header returns the envelope header for the algorithm.
+func Header(alg Algorithm) []byte {
+	return []byte{EnvelopeVersion, byte(alg)}
+}
+
+// ParseHeader returns the algorithm of an envelope, after checking its
+// version.
+func ParseHeader(ciphertext []byte) (Algorithm, error) {
+	if len(ciphertext) < HeaderSize {
+		return 0, ErrEnvelopeTooShort
+	}
+	if ciphertext[0] != EnvelopeVersion {
+		return 0, fmt.Errorf("%w %d", ErrUnsupportedVersion, ciphertext[0])
+	}
+	return Algorithm(ciphertext[1]), nil
+}
+
+// SealAEAD encrypts the plaintext into an envelope with a random nonce.
+func SealAEAD(alg Algorithm, aead cipher.AEAD, plaintext []byte) []byte {
+	header := Header(alg)
+	nonce := random.CRandBytes(aead.NonceSize())
+	out := make([]byte, 0, HeaderSize+len(nonce)+len(plaintext)+aead.Overhead())
+	out = append(out, header...)
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, plaintext, header)
+}
+
+// OpenAEAD decrypts an envelope produced by SealAEAD with the same algorithm.
+func OpenAEAD(alg Algorithm, aead cipher.AEAD, ciphertext []byte) ([]byte, error) {
+	body, err := OpenHeader(alg, ciphertext, aead.NonceSize()+aead.Overhead())
+	if err != nil {
+		return nil, err
+	}
+	nonce, sealed := body[:aead.NonceSize()], body[aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, ciphertext[:HeaderSize])
+}
+
+// OpenHeader checks that the envelope was produced by alg and holds at least
+// minBody bytes after the header, and returns those bytes.
+func OpenHeader(alg Algorithm, ciphertext []byte, minBody int) ([]byte, error) {
+	got, err := ParseHeader(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	if got != alg {
+		return nil, fmt.Errorf("%w: expected %v, got %v", ErrAlgorithmMismatch, alg, got)
+	}
+	if len(ciphertext) < HeaderSize+minBody {
+		return nil, ErrEnvelopeTooShort
+	}
+	return ciphertext[HeaderSize:], nil
+}
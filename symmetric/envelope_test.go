@@ -0,0 +1,60 @@
+package symmetric_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/crypto/symmetric"
+	"github.com/cosmos/crypto/symmetric/aes256gcm"
+	"github.com/cosmos/crypto/symmetric/xchacha20poly1305"
+	"github.com/cosmos/crypto/symmetric/xsalsa20symmetric"
+)
+
+func TestParseHeader(t *testing.T) {
+	_, err := symmetric.ParseHeader([]byte{symmetric.EnvelopeVersion})
+	require.ErrorIs(t, err, symmetric.ErrEnvelopeTooShort)
+	require.ErrorIs(t, err, symmetric.ErrInvalidCiphertextLen)
+
+	_, err = symmetric.ParseHeader([]byte{symmetric.EnvelopeVersion + 1, byte(symmetric.AES256GCM)})
+	require.ErrorIs(t, err, symmetric.ErrUnsupportedVersion)
+
+	alg, err := symmetric.ParseHeader(symmetric.Header(symmetric.XChaCha20Poly1305))
+	require.NoError(t, err)
+	require.Equal(t, symmetric.XChaCha20Poly1305, alg)
+	require.Equal(t, "xchacha20-poly1305", alg.String())
+	require.Equal(t, "Algorithm(9)", symmetric.Algorithm(9).String())
+}
+
+func TestDecryptDispatchesOnHeader(t *testing.T) {
+	ciphers := map[symmetric.Algorithm]symmetric.Symmetric{
+		symmetric.XSalsa20Poly1305:  xsalsa20symmetric.NewSymmetric(),
+		symmetric.XChaCha20Poly1305: xchacha20poly1305.NewSymmetric(),
+		symmetric.AES256GCM:         aes256gcm.NewSymmetric(),
+	}
+	plaintext := []byte("sometext")
+	for alg, s := range ciphers {
+		t.Run(alg.String(), func(t *testing.T) {
+			registered, err := symmetric.Get(alg)
+			require.NoError(t, err)
+			require.Equal(t, s, registered)
+
+			secret := s.Keygen()
+			ciphertext := s.Encrypt(plaintext, secret)
+			plaintext2, err := symmetric.Decrypt(ciphertext, secret)
+			require.NoError(t, err)
+			require.Equal(t, plaintext, plaintext2)
+
+			require.Panics(t, func() { s.Encrypt(plaintext, secret[:16]) })
+			_, err = s.(symmetric.SymmetricE).EncryptE(plaintext, secret[:16])
+			require.ErrorIs(t, err, symmetric.ErrInvalidKeyLen)
+			_, err = symmetric.Decrypt(ciphertext, secret[:16])
+			require.ErrorIs(t, err, symmetric.ErrInvalidKeyLen)
+			_, err = symmetric.Decrypt(ciphertext, s.Keygen())
+			require.ErrorIs(t, err, symmetric.ErrDecryption)
+		})
+	}
+
+	_, err := symmetric.Decrypt(symmetric.Header(symmetric.Algorithm(9)), make([]byte, 32))
+	require.ErrorIs(t, err, symmetric.ErrUnknownAlgorithm)
+}
@@ -0,0 +1,35 @@
+package symmetric
+
+import "errors"
+
+// Errors shared by the cipher packages. Each cipher package declares its own
+// error values, which keep their package-specific messages but match these
+// with errors.Is, so callers can handle failures without knowing the cipher.
+var (
+	ErrInvalidKeyLen        = errors.New("symmetric: invalid key length")
+	ErrInvalidNonceLen      = errors.New("symmetric: invalid nonce length")
+	ErrInvalidCiphertextLen = errors.New("symmetric: invalid ciphertext length")
+	ErrPlaintextTooLarge    = errors.New("symmetric: plaintext too large")
+	ErrDecryption           = errors.New("symmetric: message authentication failed")
+)
+
+// Error is an error with its own message that matches a shared error, its
+// kind, with errors.Is.
+type Error struct {
+	kind error
+	msg  string
+}
+
+// NewError returns an error with the message msg matching kind.
+func NewError(kind error, msg string) error {
+	return &Error{kind: kind, msg: msg}
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the kind of the error.
+func (e *Error) Unwrap() error {
+	return e.kind
+}
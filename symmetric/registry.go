@@ -0,0 +1,59 @@
+package symmetric
+
+import (
+	"errors"
+	"fmt"
+
+	cmtsync "github.com/cosmos/crypto/internal/sync"
+)
+
+// ErrUnknownAlgorithm is returned when no Symmetric is registered for the
+// algorithm of an envelope.
+var ErrUnknownAlgorithm = errors.New("symmetric: unknown algorithm")
+
+var registry = struct {
+	cmtsync.RWMutex
+	byAlgorithm map[Algorithm]Symmetric
+}{byAlgorithm: map[Algorithm]Symmetric{}}
+
+// Register makes a Symmetric available to Get and Decrypt. The cipher
+// packages register themselves when imported.
+//
+// Should only be called in init() functions, as it panics on error.
+func Register(alg Algorithm, s Symmetric) {
+	if s == nil {
+		panic("cannot register nil Symmetric")
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.byAlgorithm[alg]; ok {
+		panic(fmt.Sprintf("symmetric algorithm %v is already registered", alg))
+	}
+	registry.byAlgorithm[alg] = s
+}
+
+// Get returns the Symmetric registered for the algorithm.
+func Get(alg Algorithm) (Symmetric, error) {
+	registry.RLock()
+	defer registry.RUnlock()
+	s, ok := registry.byAlgorithm[alg]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", ErrUnknownAlgorithm, alg)
+	}
+	return s, nil
+}
+
+// Decrypt decrypts an envelope with the Symmetric registered for its
+// algorithm, so that data remains readable after switching ciphers. The
+// package of the cipher must have been imported.
+func Decrypt(ciphertext []byte, secret []byte) (plaintext []byte, err error) {
+	alg, err := ParseHeader(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	s, err := Get(alg)
+	if err != nil {
+		return nil, err
+	}
+	return s.Decrypt(ciphertext, secret)
+}
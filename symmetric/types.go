@@ -1,7 +1,18 @@
 package symmetric
 
+// Symmetric is a symmetric cipher producing self-describing envelopes, see
+// EnvelopeVersion. Encrypt panics if the secret has the wrong size, Decrypt
+// returns an error matching ErrInvalidKeyLen.
 type Symmetric interface {
 	Keygen() []byte
 	Encrypt(plaintext []byte, secret []byte) (ciphertext []byte)
 	Decrypt(ciphertext []byte, secret []byte) (plaintext []byte, err error)
 }
+
+// SymmetricE is a Symmetric which can also encrypt without panicking: EncryptE
+// returns an error matching ErrInvalidKeyLen if the secret has the wrong size.
+// The ciphers of this module all implement it.
+type SymmetricE interface {
+	Symmetric
+	EncryptE(plaintext []byte, secret []byte) (ciphertext []byte, err error)
+}
@@ -0,0 +1,45 @@
+package xchacha20poly1305
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/crypto/random"
+	"github.com/cosmos/crypto/symmetric"
+)
+
+func TestErrorReturningAPI(t *testing.T) {
+	key := random.CRandBytes(KeySize)
+	nonce := random.CRandBytes(NonceSize)
+	ad := []byte("additional data")
+
+	ciphertext, err := Encrypt(key, nonce, []byte("sometext"), ad)
+	require.NoError(t, err)
+	plaintext, err := Decrypt(key, nonce, ciphertext, ad)
+	require.NoError(t, err)
+	require.Equal(t, []byte("sometext"), plaintext)
+
+	_, err = Encrypt(key[:16], nonce, nil, nil)
+	require.ErrorIs(t, err, ErrInvalidKeyLen)
+	require.ErrorIs(t, err, symmetric.ErrInvalidKeyLen)
+	_, err = Decrypt(nil, nonce, ciphertext, ad)
+	require.ErrorIs(t, err, symmetric.ErrInvalidKeyLen)
+
+	_, err = Encrypt(key, nonce[:12], nil, nil)
+	require.ErrorIs(t, err, ErrInvalidNonceLen)
+	require.ErrorIs(t, err, symmetric.ErrInvalidNonceLen)
+	_, err = Decrypt(key, nonce[:12], ciphertext, ad)
+	require.ErrorIs(t, err, symmetric.ErrInvalidNonceLen)
+
+	_, err = Decrypt(key, nonce, ciphertext, nil)
+	require.ErrorIs(t, err, ErrOpen)
+	require.ErrorIs(t, err, symmetric.ErrDecryption)
+	require.EqualError(t, err, "xchacha20poly1305: message authentication failed")
+
+	_, err = NewSymmetric().Decrypt(ciphertext, key[:16])
+	require.ErrorIs(t, err, symmetric.ErrInvalidKeyLen)
+	require.ErrorIs(t, ErrInvalidStream, symmetric.ErrDecryption)
+	require.ErrorIs(t, ErrInvalidCipherTextLen, symmetric.ErrInvalidCiphertextLen)
+	require.ErrorIs(t, ErrPlaintextTooLarge, symmetric.ErrPlaintextTooLarge)
+}
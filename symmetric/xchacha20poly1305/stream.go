@@ -0,0 +1,244 @@
+package xchacha20poly1305
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"github.com/cosmos/crypto/symmetric"
+)
+
+// The stream construction follows STREAM (Hoang, Reyhanitabar, Rogaway and
+// Vizár, "Online Authenticated-Encryption and its Nonce-Reuse
+// Misuse-Resistance"). The plaintext is split into chunks of ChunkSize bytes,
+// the last of which may be shorter or empty, and each chunk is sealed
+// separately with ChaCha20-Poly1305 under
+//
+//	key   = HChaCha20(key, nonce[:16])
+//	nonce = (counter XOR nonce[16:24]) || 0x000000 || last
+//
+// where counter is the big-endian index of the chunk and last is 1 for the
+// final chunk and 0 otherwise. Binding the index and the final flag into the
+// nonce makes reordered, dropped or truncated chunks fail authentication.
+
+const (
+	// ChunkSize is the size of the plaintext chunks of a stream, in bytes.
+	ChunkSize = 64 * 1024
+
+	encChunkSize = ChunkSize + TagSize
+)
+
+var (
+	ErrInvalidStream = symmetric.NewError(symmetric.ErrDecryption, "xchacha20poly1305: invalid or truncated stream")
+	ErrStreamClosed  = errors.New("xchacha20poly1305: write to closed stream")
+	errStreamTooLong = errors.New("xchacha20poly1305: stream too long")
+)
+
+// streamCipher seals and opens the chunks of a stream.
+type streamCipher struct {
+	aead    cipher.AEAD
+	prefix  [8]byte
+	counter uint64
+}
+
+func newStreamCipher(key, nonce []byte) (*streamCipher, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKeyLen
+	}
+	if len(nonce) != NonceSize {
+		return nil, ErrInvalidNonceLen
+	}
+	var subKey, keyArr [KeySize]byte
+	var hNonce [16]byte
+	defer clear(subKey[:])
+	defer clear(keyArr[:])
+	copy(keyArr[:], key)
+	copy(hNonce[:], nonce[:16])
+	HChaCha20(&subKey, &hNonce, &keyArr)
+
+	// This can't error because we always provide a correctly sized key
+	aead, _ := chacha20poly1305.New(subKey[:])
+	s := &streamCipher{aead: aead}
+	copy(s.prefix[:], nonce[16:])
+	return s, nil
+}
+
+// nextNonce returns the nonce of the next chunk and advances the counter.
+func (s *streamCipher) nextNonce(last bool) ([chacha20poly1305.NonceSize]byte, error) {
+	var nonce [chacha20poly1305.NonceSize]byte
+	if s.counter == ^uint64(0) {
+		return nonce, errStreamTooLong
+	}
+	binary.BigEndian.PutUint64(nonce[:8], s.counter)
+	for i := range s.prefix {
+		nonce[i] ^= s.prefix[i]
+	}
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	s.counter++
+	return nonce, nil
+}
+
+// Writer encrypts a stream of data. It must be closed to write the final
+// chunk; a stream that was not closed fails to decrypt.
+type Writer struct {
+	s   *streamCipher
+	dst io.Writer
+	buf []byte
+	err error
+}
+
+// NewWriter returns a Writer encrypting to dst with the KeySize key and the
+// NonceSize nonce. As with Seal, a nonce must never be reused with the same
+// key; a random one from random.CRandBytes is safe. The nonce is not written
+// to dst and must be passed to NewReader along with the key.
+func NewWriter(dst io.Writer, key, nonce []byte) (*Writer, error) {
+	s, err := newStreamCipher(key, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &Writer{
+		s:   s,
+		dst: dst,
+		buf: make([]byte, 0, encChunkSize),
+	}, nil
+}
+
+// Write encrypts p. Data is buffered and written to the underlying writer one
+// chunk at a time.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n := 0
+	for len(p) > 0 {
+		// A full chunk is only flushed once more data follows, since the
+		// final chunk is sealed differently.
+		if len(w.buf) == ChunkSize {
+			if err := w.flush(false); err != nil {
+				return n, err
+			}
+		}
+		k := copy(w.buf[len(w.buf):ChunkSize], p)
+		w.buf = w.buf[:len(w.buf)+k]
+		p = p[k:]
+		n += k
+	}
+	return n, nil
+}
+
+// Close writes the final chunk. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		if w.err == ErrStreamClosed {
+			return nil
+		}
+		return w.err
+	}
+	if err := w.flush(true); err != nil {
+		return err
+	}
+	w.err = ErrStreamClosed
+	return nil
+}
+
+func (w *Writer) flush(last bool) error {
+	nonce, err := w.s.nextNonce(last)
+	if err != nil {
+		w.err = err
+		return err
+	}
+	out := w.s.aead.Seal(w.buf[:0], nonce[:], w.buf, nil)
+	if _, err := w.dst.Write(out); err != nil {
+		w.err = err
+		return err
+	}
+	w.buf = w.buf[:0]
+	return nil
+}
+
+// Reader decrypts a stream produced by Writer. Read returns ErrInvalidStream
+// as soon as a chunk fails authentication, including when the stream ends
+// before its final chunk, and io.EOF only after the final chunk.
+type Reader struct {
+	s        *streamCipher
+	src      io.Reader
+	buf      []byte
+	carry    int
+	plain    []byte
+	plainBuf []byte
+	done     bool
+	err      error
+}
+
+// NewReader returns a Reader decrypting src with the key and nonce the stream
+// was written with.
+func NewReader(src io.Reader, key, nonce []byte) (*Reader, error) {
+	s, err := newStreamCipher(key, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{
+		s:        s,
+		src:      src,
+		buf:      make([]byte, encChunkSize+1),
+		plainBuf: make([]byte, 0, ChunkSize),
+	}, nil
+}
+
+// Read decrypts into p. Data of a chunk is only returned once the whole chunk
+// has been authenticated.
+func (r *Reader) Read(p []byte) (int, error) {
+	for len(r.plain) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if r.done {
+			return 0, io.EOF
+		}
+		r.err = r.readChunk()
+	}
+	n := copy(p, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
+
+// readChunk reads and opens the next chunk. One byte past the chunk is read
+// ahead to tell whether it is the final one.
+func (r *Reader) readChunk() error {
+	n, err := io.ReadFull(r.src, r.buf[r.carry:])
+	n += r.carry
+	var last bool
+	switch err {
+	case nil:
+		n = encChunkSize
+	case io.EOF, io.ErrUnexpectedEOF:
+		last = true
+	default:
+		return err
+	}
+	if n < TagSize {
+		return ErrInvalidStream
+	}
+
+	nonce, err := r.s.nextNonce(last)
+	if err != nil {
+		return err
+	}
+	plain, err := r.s.aead.Open(r.plainBuf[:0], nonce[:], r.buf[:n], nil)
+	if err != nil {
+		return ErrInvalidStream
+	}
+	if last {
+		r.done = true
+	} else {
+		r.buf[0] = r.buf[encChunkSize]
+		r.carry = 1
+	}
+	r.plain = plain
+	return nil
+}
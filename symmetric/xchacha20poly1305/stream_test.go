@@ -0,0 +1,109 @@
+package xchacha20poly1305
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/crypto/random"
+)
+
+func encryptStream(t *testing.T, key, nonce, plaintext []byte, writeSize int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, key, nonce)
+	require.NoError(t, err)
+	for p := plaintext; len(p) > 0; {
+		k := min(writeSize, len(p))
+		n, err := w.Write(p[:k])
+		require.NoError(t, err)
+		require.Equal(t, k, n)
+		p = p[k:]
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func decryptStream(key, nonce, ciphertext []byte) ([]byte, error) {
+	r, err := NewReader(iotest.HalfReader(bytes.NewReader(ciphertext)), key, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestStreamRoundTrip(t *testing.T) {
+	key := random.CRandBytes(KeySize)
+	nonce := random.CRandBytes(NonceSize)
+	for _, size := range []int{0, 1, ChunkSize - 1, ChunkSize, ChunkSize + 1, 3*ChunkSize + 17} {
+		plaintext := random.CRandBytes(size)
+		for _, writeSize := range []int{1000, ChunkSize, 2*ChunkSize + 5} {
+			ciphertext := encryptStream(t, key, nonce, plaintext, writeSize)
+			chunks := max(1, (size+ChunkSize-1)/ChunkSize)
+			if size > 0 && size%ChunkSize == 0 {
+				// A full final chunk is still sealed as the final one.
+				chunks = size / ChunkSize
+			}
+			require.Len(t, ciphertext, size+chunks*TagSize)
+
+			decrypted, err := decryptStream(key, nonce, ciphertext)
+			require.NoError(t, err, "size %d", size)
+			require.Equal(t, len(plaintext), len(decrypted))
+			require.True(t, bytes.Equal(plaintext, decrypted))
+		}
+	}
+}
+
+func TestStreamDetectsTampering(t *testing.T) {
+	key := random.CRandBytes(KeySize)
+	nonce := random.CRandBytes(NonceSize)
+	plaintext := random.CRandBytes(3 * ChunkSize)
+	ciphertext := encryptStream(t, key, nonce, plaintext, ChunkSize)
+
+	cases := map[string][]byte{
+		"empty":              nil,
+		"truncated at chunk": ciphertext[:2*encChunkSize],
+		"truncated in chunk": ciphertext[:len(ciphertext)-1],
+		"trailing data":      append(append([]byte(nil), ciphertext...), 0),
+		"reordered": append(append(append([]byte(nil),
+			ciphertext[encChunkSize:2*encChunkSize]...),
+			ciphertext[:encChunkSize]...),
+			ciphertext[2*encChunkSize:]...),
+	}
+	flipped := append([]byte(nil), ciphertext...)
+	flipped[encChunkSize+5] ^= 1
+	cases["flipped bit"] = flipped
+
+	for name, ct := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := decryptStream(key, nonce, ct)
+			require.ErrorIs(t, err, ErrInvalidStream)
+		})
+	}
+
+	_, err := decryptStream(random.CRandBytes(KeySize), nonce, ciphertext)
+	require.ErrorIs(t, err, ErrInvalidStream)
+	otherNonce := append([]byte(nil), nonce...)
+	otherNonce[NonceSize-1] ^= 1
+	_, err = decryptStream(key, otherNonce, ciphertext)
+	require.ErrorIs(t, err, ErrInvalidStream)
+}
+
+func TestStreamWriterClose(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, random.CRandBytes(KeySize), random.CRandBytes(NonceSize))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, w.Close())
+	require.Equal(t, TagSize, buf.Len())
+	_, err = w.Write([]byte("more"))
+	require.ErrorIs(t, err, ErrStreamClosed)
+
+	_, err = NewWriter(&buf, make([]byte, KeySize-1), make([]byte, NonceSize))
+	require.ErrorIs(t, err, ErrInvalidKeyLen)
+	_, err = NewReader(&buf, make([]byte, KeySize), make([]byte, NonceSize-1))
+	require.ErrorIs(t, err, ErrInvalidNonceLen)
+}
@@ -0,0 +1,58 @@
+package xchacha20poly1305
+
+import (
+	"fmt"
+
+	"github.com/cosmos/crypto/random"
+	"github.com/cosmos/crypto/symmetric"
+)
+
+func init() {
+	symmetric.Register(symmetric.XChaCha20Poly1305, NewSymmetric())
+}
+
+// xchachaSymmetric implements symmetric.Symmetric.
+type xchachaSymmetric struct{}
+
+var _ symmetric.SymmetricE = xchachaSymmetric{}
+
+// NewSymmetric returns a symmetric.Symmetric producing XChaCha20-Poly1305
+// envelopes with random nonces.
+func NewSymmetric() symmetric.SymmetricE {
+	return xchachaSymmetric{}
+}
+
+// Keygen returns a random KeySize secret.
+func (xchachaSymmetric) Keygen() []byte {
+	return random.CRandBytes(KeySize)
+}
+
+// Encrypt seals the plaintext into an envelope. secret must be KeySize bytes
+// long.
+func (s xchachaSymmetric) Encrypt(plaintext []byte, secret []byte) (ciphertext []byte) {
+	ciphertext, err := s.EncryptE(plaintext, secret)
+	if err != nil {
+		panic(fmt.Sprintf("Secret must be %d bytes long, got len %v", KeySize, len(secret)))
+	}
+	return ciphertext
+}
+
+// EncryptE is like Encrypt, but returns ErrInvalidKeyLen instead of panicking
+// if secret is not KeySize bytes long.
+func (xchachaSymmetric) EncryptE(plaintext []byte, secret []byte) (ciphertext []byte, err error) {
+	aead, err := New(secret)
+	if err != nil {
+		return nil, err
+	}
+	return symmetric.SealAEAD(symmetric.XChaCha20Poly1305, aead, plaintext), nil
+}
+
+// Decrypt opens an envelope produced by Encrypt. It returns ErrInvalidKeyLen
+// if secret is not KeySize bytes long.
+func (xchachaSymmetric) Decrypt(ciphertext []byte, secret []byte) (plaintext []byte, err error) {
+	aead, err := New(secret)
+	if err != nil {
+		return nil, err
+	}
+	return symmetric.OpenAEAD(symmetric.XChaCha20Poly1305, aead, ciphertext)
+}
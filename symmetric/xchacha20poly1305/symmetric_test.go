@@ -0,0 +1,45 @@
+package xchacha20poly1305
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/crypto/symmetric"
+)
+
+func TestSymmetricRoundTrip(t *testing.T) {
+	s := NewSymmetric()
+	secret := s.Keygen()
+	require.Len(t, secret, KeySize)
+
+	plaintext := []byte("sometext")
+	ciphertext := s.Encrypt(plaintext, secret)
+	require.Equal(t, symmetric.Header(symmetric.XChaCha20Poly1305), ciphertext[:symmetric.HeaderSize])
+	require.Len(t, ciphertext, symmetric.HeaderSize+NonceSize+TagSize+len(plaintext))
+
+	plaintext2, err := s.Decrypt(ciphertext, secret)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, plaintext2)
+}
+
+func TestSymmetricAuthenticatesHeader(t *testing.T) {
+	s := NewSymmetric()
+	secret := s.Keygen()
+	ciphertext := s.Encrypt([]byte("sometext"), secret)
+
+	tampered := append([]byte(nil), ciphertext...)
+	tampered[0]++
+	_, err := s.Decrypt(tampered, secret)
+	require.ErrorIs(t, err, symmetric.ErrUnsupportedVersion)
+
+	tampered = append([]byte(nil), ciphertext...)
+	tampered[1] = byte(symmetric.AES256GCM)
+	_, err = s.Decrypt(tampered, secret)
+	require.ErrorIs(t, err, symmetric.ErrAlgorithmMismatch)
+
+	tampered = append([]byte(nil), ciphertext...)
+	tampered[symmetric.HeaderSize] ^= 1
+	_, err = s.Decrypt(tampered, secret)
+	require.Error(t, err)
+}
@@ -5,9 +5,10 @@ package xchacha20poly1305
 import (
 	"crypto/cipher"
 	"encoding/binary"
-	"errors"
 
 	"golang.org/x/crypto/chacha20poly1305"
+
+	"github.com/cosmos/crypto/symmetric"
 )
 
 // Implements crypto.AEAD.
@@ -36,10 +37,13 @@ const (
 	sigma3 = uint32(0x6b206574)
 )
 
+// The errors match the shared errors of package symmetric with errors.Is.
 var (
-	ErrInvalidKeyLen        = errors.New("xchacha20poly1305: bad key length")
-	ErrInvalidNonceLen      = errors.New("xchacha20poly1305: bad nonce length")
-	ErrInvalidCipherTextLen = errors.New("xchacha20poly1305: ciphertext too large")
+	ErrInvalidKeyLen        = symmetric.NewError(symmetric.ErrInvalidKeyLen, "xchacha20poly1305: bad key length")
+	ErrInvalidNonceLen      = symmetric.NewError(symmetric.ErrInvalidNonceLen, "xchacha20poly1305: bad nonce length")
+	ErrInvalidCipherTextLen = symmetric.NewError(symmetric.ErrInvalidCiphertextLen, "xchacha20poly1305: ciphertext too large")
+	ErrPlaintextTooLarge    = symmetric.NewError(symmetric.ErrPlaintextTooLarge, "xchacha20poly1305: plaintext too large")
+	ErrOpen                 = symmetric.NewError(symmetric.ErrDecryption, "xchacha20poly1305: message authentication failed")
 )
 
 // New returns a new xchachapoly1305 AEAD.
@@ -66,7 +70,7 @@ func (c *xchacha20poly1305) Seal(dst, nonce, plaintext, additionalData []byte) [
 	}
 
 	if uint64(len(plaintext)) > MaxPlaintextSize {
-		panic("xchacha20poly1305: plaintext too large")
+		panic(ErrPlaintextTooLarge.Error())
 	}
 
 	var subKey [KeySize]byte
@@ -103,7 +107,38 @@ func (c *xchacha20poly1305) Open(dst, nonce, ciphertext, additionalData []byte)
 
 	copy(subNonce[4:], nonce[16:])
 
-	return chacha20poly1305.Open(dst, subNonce[:], ciphertext, additionalData)
+	plaintext, err := chacha20poly1305.Open(dst, subNonce[:], ciphertext, additionalData)
+	if err != nil {
+		return nil, ErrOpen
+	}
+	return plaintext, nil
+}
+
+// Encrypt is like Seal on an AEAD created with New, but returns an error
+// instead of panicking on a bad key or nonce length or an oversized
+// plaintext, so that it is safe to call with untrusted input.
+func Encrypt(key, nonce, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := New(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != NonceSize {
+		return nil, ErrInvalidNonceLen
+	}
+	if uint64(len(plaintext)) > MaxPlaintextSize {
+		return nil, ErrPlaintextTooLarge
+	}
+	return aead.Seal(nil, nonce, plaintext, additionalData), nil
+}
+
+// Decrypt is like Open on an AEAD created with New. It returns
+// ErrInvalidKeyLen instead of requiring a valid key.
+func Decrypt(key, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	aead, err := New(key)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Open(nil, nonce, ciphertext, additionalData)
 }
 
 // HChaCha exported from
@@ -1,31 +1,55 @@
 package xsalsa20symmetric
 
 import (
-	"errors"
 	"fmt"
-	crypto "github.com/cosmos/crypto/random"
 
 	"golang.org/x/crypto/nacl/secretbox"
+
+	crypto "github.com/cosmos/crypto/random"
+	"github.com/cosmos/crypto/symmetric"
 )
 
-// TODO, make this into a struct that implements crypto.Symmetric.
+func init() {
+	symmetric.Register(symmetric.XSalsa20Poly1305, NewSymmetric())
+}
 
 const (
 	nonceLen  = 24
 	secretLen = 32
 )
 
+// The errors match the shared errors of package symmetric with errors.Is.
 var (
-	ErrInvalidCiphertextLen = errors.New("xsalsa20symmetric: ciphertext is too short")
-	ErrCiphertextDecryption = errors.New("xsalsa20symmetric: ciphertext decryption failed")
+	ErrInvalidKeyLen        = symmetric.NewError(symmetric.ErrInvalidKeyLen, "xsalsa20symmetric: secret must be 32 bytes long")
+	ErrInvalidCiphertextLen = symmetric.NewError(symmetric.ErrInvalidCiphertextLen, "xsalsa20symmetric: ciphertext is too short")
+	ErrCiphertextDecryption = symmetric.NewError(symmetric.ErrDecryption, "xsalsa20symmetric: ciphertext decryption failed")
 )
 
-// secret must be 32 bytes long. Use something like Sha256(Bcrypt(passphrase))
+// secret must be 32 bytes long. Use kdf.Derive to obtain one from a passphrase.
 // The ciphertext is (secretbox.Overhead + 24) bytes longer than the plaintext.
 func EncryptSymmetric(plaintext []byte, secret []byte) (ciphertext []byte) {
+	ciphertext, err := Encrypt(plaintext, secret)
+	if err != nil {
+		panic(fmt.Sprintf("Secret must be 32 bytes long, got len %v", len(secret)))
+	}
+	return ciphertext
+}
+
+// secret must be 32 bytes long. Use kdf.Derive to obtain one from a passphrase.
+// The ciphertext is (secretbox.Overhead + 24) bytes longer than the plaintext.
+func DecryptSymmetric(ciphertext []byte, secret []byte) (plaintext []byte, err error) {
 	if len(secret) != secretLen {
 		panic(fmt.Sprintf("Secret must be 32 bytes long, got len %v", len(secret)))
 	}
+	return Decrypt(ciphertext, secret)
+}
+
+// Encrypt is like EncryptSymmetric, but returns ErrInvalidKeyLen instead of
+// panicking if secret is not 32 bytes long.
+func Encrypt(plaintext []byte, secret []byte) (ciphertext []byte, err error) {
+	if len(secret) != secretLen {
+		return nil, ErrInvalidKeyLen
+	}
 	nonce := crypto.CRandBytes(nonceLen)
 	nonceArr := [nonceLen]byte{}
 	copy(nonceArr[:], nonce)
@@ -34,14 +58,14 @@ func EncryptSymmetric(plaintext []byte, secret []byte) (ciphertext []byte) {
 	ciphertext = make([]byte, nonceLen+secretbox.Overhead+len(plaintext))
 	copy(ciphertext, nonce)
 	secretbox.Seal(ciphertext[nonceLen:nonceLen], plaintext, &nonceArr, &secretArr)
-	return ciphertext
+	return ciphertext, nil
 }
 
-// secret must be 32 bytes long. Use something like Sha256(Bcrypt(passphrase))
-// The ciphertext is (secretbox.Overhead + 24) bytes longer than the plaintext.
-func DecryptSymmetric(ciphertext []byte, secret []byte) (plaintext []byte, err error) {
+// Decrypt is like DecryptSymmetric, but returns ErrInvalidKeyLen instead of
+// panicking if secret is not 32 bytes long.
+func Decrypt(ciphertext []byte, secret []byte) (plaintext []byte, err error) {
 	if len(secret) != secretLen {
-		panic(fmt.Sprintf("Secret must be 32 bytes long, got len %v", len(secret)))
+		return nil, ErrInvalidKeyLen
 	}
 	if len(ciphertext) <= secretbox.Overhead+nonceLen {
 		return nil, ErrInvalidCiphertextLen
@@ -58,3 +82,66 @@ func DecryptSymmetric(ciphertext []byte, secret []byte) (plaintext []byte, err e
 	}
 	return plaintext, nil
 }
+
+// xsalsa20Symmetric implements symmetric.Symmetric with NaCl secretbox.
+type xsalsa20Symmetric struct{}
+
+var _ symmetric.SymmetricE = xsalsa20Symmetric{}
+
+// NewSymmetric returns a symmetric.Symmetric producing XSalsa20-Poly1305
+// envelopes. Unlike EncryptSymmetric, the envelope starts with
+// symmetric.HeaderSize bytes identifying the cipher.
+func NewSymmetric() symmetric.SymmetricE {
+	return xsalsa20Symmetric{}
+}
+
+// Keygen returns a random 32 byte secret.
+func (xsalsa20Symmetric) Keygen() []byte {
+	return crypto.CRandBytes(secretLen)
+}
+
+// Encrypt seals the plaintext into an envelope. secret must be 32 bytes long.
+func (s xsalsa20Symmetric) Encrypt(plaintext []byte, secret []byte) (ciphertext []byte) {
+	ciphertext, err := s.EncryptE(plaintext, secret)
+	if err != nil {
+		panic(fmt.Sprintf("Secret must be 32 bytes long, got len %v", len(secret)))
+	}
+	return ciphertext
+}
+
+// EncryptE is like Encrypt, but returns ErrInvalidKeyLen instead of panicking
+// if secret is not 32 bytes long.
+func (xsalsa20Symmetric) EncryptE(plaintext []byte, secret []byte) (ciphertext []byte, err error) {
+	if len(secret) != secretLen {
+		return nil, ErrInvalidKeyLen
+	}
+	var nonceArr [nonceLen]byte
+	copy(nonceArr[:], crypto.CRandBytes(nonceLen))
+	var secretArr [secretLen]byte
+	copy(secretArr[:], secret)
+	ciphertext = make([]byte, 0, symmetric.HeaderSize+nonceLen+secretbox.Overhead+len(plaintext))
+	ciphertext = append(ciphertext, symmetric.Header(symmetric.XSalsa20Poly1305)...)
+	ciphertext = append(ciphertext, nonceArr[:]...)
+	return secretbox.Seal(ciphertext, plaintext, &nonceArr, &secretArr), nil
+}
+
+// Decrypt opens an envelope produced by Encrypt. It returns ErrInvalidKeyLen
+// if secret is not 32 bytes long.
+func (xsalsa20Symmetric) Decrypt(ciphertext []byte, secret []byte) (plaintext []byte, err error) {
+	if len(secret) != secretLen {
+		return nil, ErrInvalidKeyLen
+	}
+	body, err := symmetric.OpenHeader(symmetric.XSalsa20Poly1305, ciphertext, nonceLen+secretbox.Overhead)
+	if err != nil {
+		return nil, err
+	}
+	var nonceArr [nonceLen]byte
+	copy(nonceArr[:], body[:nonceLen])
+	var secretArr [secretLen]byte
+	copy(secretArr[:], secret)
+	plaintext, ok := secretbox.Open(nil, body[nonceLen:], &nonceArr, &secretArr)
+	if !ok {
+		return nil, ErrCiphertextDecryption
+	}
+	return plaintext, nil
+}
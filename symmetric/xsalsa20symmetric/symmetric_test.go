@@ -1,12 +1,16 @@
 package xsalsa20symmetric
 
 import (
-	"github.com/cosmos/crypto/hash/sha256"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/nacl/secretbox"
+
+	"github.com/cosmos/crypto/hash/sha256"
+	crypto "github.com/cosmos/crypto/random"
+	"github.com/cosmos/crypto/symmetric"
 )
 
 func TestSimple(t *testing.T) {
@@ -34,3 +38,74 @@ func TestSimpleWithKDF(t *testing.T) {
 	require.NoError(t, err, "%+v", err)
 	assert.Equal(t, plaintext, plaintext2)
 }
+
+func TestSymmetricRoundTrip(t *testing.T) {
+	s := NewSymmetric()
+	secret := s.Keygen()
+	require.Len(t, secret, secretLen)
+
+	for _, plaintext := range [][]byte{nil, []byte("sometext")} {
+		ciphertext := s.Encrypt(plaintext, secret)
+		require.Equal(t, symmetric.Header(symmetric.XSalsa20Poly1305), ciphertext[:symmetric.HeaderSize])
+		require.Len(t, ciphertext, symmetric.HeaderSize+nonceLen+secretbox.Overhead+len(plaintext))
+
+		plaintext2, err := s.Decrypt(ciphertext, secret)
+		require.NoError(t, err)
+		assert.Equal(t, len(plaintext), len(plaintext2))
+		assert.Equal(t, string(plaintext), string(plaintext2))
+	}
+}
+
+func TestSymmetricRejectsTampering(t *testing.T) {
+	s := NewSymmetric()
+	secret := s.Keygen()
+	ciphertext := s.Encrypt([]byte("sometext"), secret)
+
+	tampered := append([]byte(nil), ciphertext...)
+	tampered[len(tampered)-1] ^= 1
+	_, err := s.Decrypt(tampered, secret)
+	require.ErrorIs(t, err, ErrCiphertextDecryption)
+
+	_, err = s.Decrypt(ciphertext, s.Keygen())
+	require.ErrorIs(t, err, ErrCiphertextDecryption)
+
+	_, err = s.Decrypt(ciphertext[:symmetric.HeaderSize+nonceLen], secret)
+	require.ErrorIs(t, err, symmetric.ErrEnvelopeTooShort)
+
+	// The legacy format has no header and is not accepted.
+	_, err = s.Decrypt(EncryptSymmetric([]byte("sometext"), secret), secret)
+	require.Error(t, err)
+}
+
+func TestErrorReturningAPI(t *testing.T) {
+	secret := crypto.CRandBytes(secretLen)
+	ciphertext, err := Encrypt([]byte("sometext"), secret)
+	require.NoError(t, err)
+	plaintext, err := Decrypt(ciphertext, secret)
+	require.NoError(t, err)
+	require.Equal(t, []byte("sometext"), plaintext)
+
+	_, err = Encrypt([]byte("sometext"), secret[:16])
+	require.ErrorIs(t, err, ErrInvalidKeyLen)
+	require.ErrorIs(t, err, symmetric.ErrInvalidKeyLen)
+	_, err = Decrypt(ciphertext, secret[:16])
+	require.ErrorIs(t, err, symmetric.ErrInvalidKeyLen)
+	require.Panics(t, func() { NewSymmetric().Encrypt([]byte("sometext"), nil) })
+	_, err = NewSymmetric().EncryptE([]byte("sometext"), nil)
+	require.ErrorIs(t, err, symmetric.ErrInvalidKeyLen)
+	_, err = NewSymmetric().Decrypt(ciphertext, nil)
+	require.ErrorIs(t, err, symmetric.ErrInvalidKeyLen)
+
+	_, err = Decrypt(ciphertext[:nonceLen], secret)
+	require.ErrorIs(t, err, ErrInvalidCiphertextLen)
+	require.ErrorIs(t, err, symmetric.ErrInvalidCiphertextLen)
+	require.EqualError(t, err, "xsalsa20symmetric: ciphertext is too short")
+
+	ciphertext[len(ciphertext)-1] ^= 1
+	_, err = Decrypt(ciphertext, secret)
+	require.ErrorIs(t, err, ErrCiphertextDecryption)
+	require.ErrorIs(t, err, symmetric.ErrDecryption)
+
+	require.Panics(t, func() { EncryptSymmetric(nil, secret[:16]) })
+	require.Panics(t, func() { _, _ = DecryptSymmetric(ciphertext, secret[:16]) })
+}